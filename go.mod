@@ -1,3 +1,5 @@
 module github.com/drnp/go-xuan
 
 go 1.24.1
+
+require golang.org/x/text v0.32.0
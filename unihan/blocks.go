@@ -0,0 +1,97 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file blocks.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+// Block is a Unicode block holding CJK ideographs
+type Block struct {
+	Name  string `json:"name"`
+	First rune   `json:"first"`
+	Last  rune   `json:"last"`
+}
+
+var (
+	BlockURO                     = Block{"CJK Unified Ideographs", 0x4E00, 0x9FFF}
+	BlockExtA                    = Block{"CJK Unified Ideographs Extension A", 0x3400, 0x4DBF}
+	BlockExtB                    = Block{"CJK Unified Ideographs Extension B", 0x20000, 0x2A6DF}
+	BlockExtC                    = Block{"CJK Unified Ideographs Extension C", 0x2A700, 0x2B73F}
+	BlockExtD                    = Block{"CJK Unified Ideographs Extension D", 0x2B740, 0x2B81F}
+	BlockExtE                    = Block{"CJK Unified Ideographs Extension E", 0x2B820, 0x2CEAF}
+	BlockExtF                    = Block{"CJK Unified Ideographs Extension F", 0x2CEB0, 0x2EBEF}
+	BlockExtG                    = Block{"CJK Unified Ideographs Extension G", 0x30000, 0x3134F}
+	BlockExtH                    = Block{"CJK Unified Ideographs Extension H", 0x31350, 0x323AF}
+	BlockExtI                    = Block{"CJK Unified Ideographs Extension I", 0x2EBF0, 0x2EE5F}
+	BlockExtJ                    = Block{"CJK Unified Ideographs Extension J", 0x323B0, 0x3347F}
+	BlockCompatibility           = Block{"CJK Compatibility Ideographs", 0xF900, 0xFAFF}
+	BlockCompatibilitySupplement = Block{"CJK Compatibility Ideographs Supplement", 0x2F800, 0x2FA1F}
+
+	Blocks = []Block{
+		BlockURO,
+		BlockExtA,
+		BlockExtB,
+		BlockExtC,
+		BlockExtD,
+		BlockExtE,
+		BlockExtF,
+		BlockExtG,
+		BlockExtH,
+		BlockExtI,
+		BlockExtJ,
+		BlockCompatibility,
+		BlockCompatibilitySupplement,
+	}
+)
+
+// BlockOf returns the CJK block of a code point
+func BlockOf(r rune) (Block, bool) {
+	for _, block := range Blocks {
+		if block.Contains(r) {
+			return block, true
+		}
+	}
+
+	return Block{}, false
+}
+
+/* {{{ [Block struct] */
+func (b Block) Contains(r rune) bool {
+	return r >= b.First && r <= b.Last
+}
+
+/* }}} */
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,203 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file charset.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Source and mapping fields used by charsets
+const (
+	FieldBigFive    = "kBigFive"
+	FieldGB0        = "kGB0"
+	FieldGB1        = "kGB1"
+	FieldIRGGSource = "kIRG_GSource"
+	FieldIRGJSource = "kIRG_JSource"
+	FieldIRGTSource = "kIRG_TSource"
+	FieldJis0       = "kJis0"
+	FieldTGH        = "kTGH"
+)
+
+// Ranges of sets not carried by a field
+const (
+	tghEdition   = "2013"
+	tghTier1Size = 3500
+	tghTier2Size = 3000
+	tghTier3Size = 1605
+
+	big5CommonFirst = 0xA440
+	big5CommonLast  = 0xC67E
+	gbkLastUnified  = 0x9FA5
+)
+
+// Charset names a standard character set
+type Charset string
+
+// Known character sets
+const (
+	CharsetGB2312     Charset = "GB2312"      // kGB0, or G0 source
+	CharsetGBK        Charset = "GBK"         // GB2312 and U+4E00..U+9FA5, compatibility ideographs not included
+	CharsetBig5       Charset = "Big5"        // kBigFive
+	CharsetBig5Common Charset = "Big5-Common" // Big5 level 1, 常用字 of Taiwan (A440 - C67E)
+	CharsetJISX0208   Charset = "JIS-X-0208"  // kJis0, or J0 source
+	CharsetTGH        Charset = "TGH"         // 通用规范汉字表 (2013), all 8105 characters
+	CharsetTGH1       Charset = "TGH-1"       // 通用规范汉字表 tier 1, 常用字 (1 - 3500)
+	CharsetTGH2       Charset = "TGH-2"       // 通用规范汉字表 tier 2 (3501 - 6500)
+	CharsetTGH3       Charset = "TGH-3"       // 通用规范汉字表 tier 3 (6501 - 8105)
+)
+
+// Charsets lists all known character sets
+var Charsets = []Charset{
+	CharsetGB2312,
+	CharsetGBK,
+	CharsetBig5,
+	CharsetBig5Common,
+	CharsetJISX0208,
+	CharsetTGH,
+	CharsetTGH1,
+	CharsetTGH2,
+	CharsetTGH3,
+}
+
+// ParseCharset finds a known character set by name, case insensitive
+func ParseCharset(name string) (Charset, error) {
+	for _, cs := range Charsets {
+		if strings.EqualFold(string(cs), name) {
+			return cs, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown charset %q", name)
+}
+
+// CharsetMembers returns code points of the default database in a character set
+func CharsetMembers(cs Charset) []rune {
+	return defaultDB.Charset(cs)
+}
+
+/* {{{ [DB struct] */
+// Charset returns code points in a character set, ordered by code point
+func (db *DB) Charset(cs Charset) []rune {
+	var codePoints []rune
+	for codePoint, han := range db.view() {
+		if han.InCharset(cs) {
+			codePoints = append(codePoints, codePoint)
+		}
+	}
+
+	slices.Sort(codePoints)
+
+	return codePoints
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// InCharset reports whether the character is in a character set
+func (h *Han) InCharset(cs Charset) bool {
+	switch cs {
+	case CharsetGB2312:
+		return h.hasField(FieldGB0) || h.hasSource(FieldIRGGSource, "G0-")
+	case CharsetGBK:
+		return (h.CodePoint >= BlockURO.First && h.CodePoint <= gbkLastUnified) || h.InCharset(CharsetGB2312)
+	case CharsetBig5:
+		return h.hasField(FieldBigFive)
+	case CharsetBig5Common:
+		for _, value := range h.fieldValues(FieldBigFive) {
+			code, err := strconv.ParseUint(value, 16, 16)
+			if err == nil && code >= big5CommonFirst && code <= big5CommonLast {
+				return true
+			}
+		}
+
+		return false
+	case CharsetJISX0208:
+		return h.hasField(FieldJis0) || h.hasSource(FieldIRGJSource, "J0-")
+	case CharsetTGH:
+		return h.TGHNumber() > 0
+	case CharsetTGH1:
+		n := h.TGHNumber()
+
+		return n > 0 && n <= tghTier1Size
+	case CharsetTGH2:
+		n := h.TGHNumber()
+
+		return n > tghTier1Size && n <= tghTier1Size+tghTier2Size
+	case CharsetTGH3:
+		n := h.TGHNumber()
+
+		return n > tghTier1Size+tghTier2Size && n <= tghTier1Size+tghTier2Size+tghTier3Size
+	}
+
+	return false
+}
+
+// TGHNumber returns the serial number in 通用规范汉字表 (kTGH, 2013 edition), 0 if not listed
+func (h *Han) TGHNumber() int {
+	for _, value := range h.fieldValues(FieldTGH) {
+		edition, number, ok := strings.Cut(value, ":")
+		if ok && edition == tghEdition && isDigits(number) {
+			return atoi(number)
+		}
+	}
+
+	return 0
+}
+
+// Whether a field has any value
+func (h *Han) hasField(name string) bool {
+	return len(h.fieldValues(name)) > 0
+}
+
+// Whether an IRG source field has a value of the prefix
+func (h *Han) hasSource(name, prefix string) bool {
+	for _, value := range h.fieldValues(name) {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/* }}} */
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,308 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file codec.go
+ * @package codec
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+// Package codec transcodes legacy CJK encodings with mappings of the unihan
+// database, so one data source drives both lookup and transcoding.
+//
+// Codecs implement encoding.Encoding of golang.org/x/text. Their repertoire is
+// ASCII plus the Han characters carrying a mapping field (kGB0, kGB1, kBigFive,
+// kJis0), kana, symbols and full-width forms are not part of Unihan.
+package codec
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/drnp/go-xuan/unihan"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// Codec is a double-byte encoding built from one mapping field
+type Codec struct {
+	name   string
+	form   form
+	encode map[rune][2]byte
+	decode map[[2]byte]rune
+}
+
+// Byte layout of a double-byte encoding
+type form struct {
+	lead  func(b byte) bool
+	trail func(b byte) bool
+	code  func(value string) ([2]byte, bool) // field value to bytes
+}
+
+// Unsupported rune, recognized by encoding.ReplaceUnsupported
+type repertoireError byte
+
+var (
+	eucForm = form{
+		lead:  func(b byte) bool { return b >= 0xA1 && b <= 0xFE },
+		trail: func(b byte) bool { return b >= 0xA1 && b <= 0xFE },
+		code: func(value string) ([2]byte, bool) {
+			row, cell, ok := rowCell(value)
+
+			return [2]byte{byte(0xA0 + row), byte(0xA0 + cell)}, ok
+		},
+	}
+	big5Form = form{
+		lead:  func(b byte) bool { return b >= 0x81 && b <= 0xFE },
+		trail: func(b byte) bool { return (b >= 0x40 && b <= 0x7E) || (b >= 0xA1 && b <= 0xFE) },
+		code: func(value string) ([2]byte, bool) {
+			n, err := strconv.ParseUint(value, 16, 16)
+
+			return [2]byte{byte(n >> 8), byte(n)}, err == nil && n >= 0x8140
+		},
+	}
+	shiftJISForm = form{
+		lead:  func(b byte) bool { return (b >= 0x81 && b <= 0x9F) || (b >= 0xE0 && b <= 0xFC) },
+		trail: func(b byte) bool { return b >= 0x40 && b <= 0xFC && b != 0x7F },
+		code: func(value string) ([2]byte, bool) {
+			row, cell, ok := rowCell(value)
+			lead := (row+1)/2 + 0x80
+			if row > 62 {
+				lead += 0x40
+			}
+
+			trail := cell + 0x9E
+			if row%2 == 1 {
+				trail = cell + 0x3F
+				if cell >= 64 {
+					trail++
+				}
+			}
+
+			return [2]byte{byte(lead), byte(trail)}, ok
+		},
+	}
+)
+
+// GB2312 creates an EUC-CN codec from kGB0, db nil for the default database
+func GB2312(db *unihan.DB) *Codec {
+	return newCodec("GB2312", db, unihan.FieldGB0, eucForm)
+}
+
+// GB12345 creates an EUC codec of GB/T 12345 (traditional) from kGB1
+func GB12345(db *unihan.DB) *Codec {
+	return newCodec("GB12345", db, unihan.FieldGB1, eucForm)
+}
+
+// Big5 creates a Big5 codec from kBigFive
+func Big5(db *unihan.DB) *Codec {
+	return newCodec("Big5", db, unihan.FieldBigFive, big5Form)
+}
+
+// EUCJP creates an EUC-JP codec of JIS X 0208 from kJis0
+func EUCJP(db *unihan.DB) *Codec {
+	return newCodec("EUC-JP", db, unihan.FieldJis0, eucForm)
+}
+
+// ShiftJIS creates a Shift_JIS codec of JIS X 0208 from kJis0
+func ShiftJIS(db *unihan.DB) *Codec {
+	return newCodec("Shift_JIS", db, unihan.FieldJis0, shiftJISForm)
+}
+
+// Build both directions, the lowest code point wins when a code is mapped twice
+func newCodec(name string, db *unihan.DB, field string, f form) *Codec {
+	if db == nil {
+		db = unihan.Default()
+	}
+
+	c := &Codec{
+		name:   name,
+		form:   f,
+		encode: make(map[rune][2]byte),
+		decode: make(map[[2]byte]rune),
+	}
+
+	for han := range db.All() {
+		for _, value := range han.Field(field) {
+			code, ok := f.code(value)
+			if !ok || !f.lead(code[0]) || !f.trail(code[1]) {
+				continue
+			}
+
+			if _, exists := c.encode[han.CodePoint]; !exists {
+				c.encode[han.CodePoint] = code
+			}
+
+			if _, exists := c.decode[code]; !exists {
+				c.decode[code] = han.CodePoint
+			}
+		}
+	}
+
+	return c
+}
+
+/* {{{ [Codec struct] */
+// String returns the encoding name
+func (c *Codec) String() string {
+	return c.name
+}
+
+// Len returns the number of mapped characters, ASCII excluded
+func (c *Codec) Len() int {
+	return len(c.encode)
+}
+
+// NewDecoder returns a decoder to UTF-8, unmapped sequences decode to U+FFFD
+func (c *Codec) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: decoder{c}}
+}
+
+// NewEncoder returns an encoder from UTF-8, unmapped runes fail unless wrapped
+// by encoding.ReplaceUnsupported or encoding.HTMLEscapeUnsupported
+func (c *Codec) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: encoder{c}}
+}
+
+/* }}} */
+
+type decoder struct {
+	*Codec
+}
+
+func (d decoder) Reset() {}
+
+func (d decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		b := src[nSrc]
+		if b < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+
+			dst[nDst] = b
+			nDst++
+			nSrc++
+
+			continue
+		}
+
+		r, size := utf8.RuneError, 1
+		if d.form.lead(b) {
+			if nSrc+1 >= len(src) {
+				if !atEOF {
+					return nDst, nSrc, transform.ErrShortSrc
+				}
+			} else if trail := src[nSrc+1]; d.form.trail(trail) {
+				size = 2
+				if mapped, ok := d.decode[[2]byte{b, trail}]; ok {
+					r = mapped
+				}
+			}
+		}
+
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc += size
+	}
+
+	return nDst, nSrc, nil
+}
+
+type encoder struct {
+	*Codec
+}
+
+func (e encoder) Reset() {}
+
+func (e encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+
+		if r < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+
+			dst[nDst] = byte(r)
+			nDst++
+			nSrc++
+
+			continue
+		}
+
+		code, ok := e.encode[r]
+		if !ok {
+			return nDst, nSrc, repertoireError(encoding.ASCIISub)
+		}
+
+		if nDst+2 > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		dst[nDst], dst[nDst+1] = code[0], code[1]
+		nDst += 2
+		nSrc += size
+	}
+
+	return nDst, nSrc, nil
+}
+
+func (r repertoireError) Error() string {
+	return "codec: rune not supported by encoding"
+}
+
+func (r repertoireError) Replacement() byte {
+	return byte(r)
+}
+
+// Decimal row / cell of kGB0, kGB1 and kJis0, "4650" is row 46 cell 50
+func rowCell(value string) (int, int, bool) {
+	if len(value) != 4 {
+		return 0, 0, false
+	}
+
+	n, err := strconv.Atoi(value)
+	row, cell := n/100, n%100
+
+	return row, cell, err == nil && row >= 1 && row <= 94 && cell >= 1 && cell <= 94
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
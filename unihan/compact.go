@@ -0,0 +1,345 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file compact.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Packed field values of one character, every field is a span of a single
+// text arena, list values separated by spaces
+type packedFields struct {
+	data  string
+	spans []fieldSpan // in category order, names sorted
+}
+
+type fieldSpan struct {
+	field    uint16 // interned name, see internField()
+	category uint8  // index of categories
+	start    uint32
+	end      uint32
+}
+
+// Field names shared by all packed characters, never changed once published
+type fieldNames struct {
+	ids   map[string]uint16
+	names []string
+}
+
+var (
+	fieldTable     atomic.Pointer[fieldNames] // copied on write, read lock free
+	fieldTableLock sync.Mutex                 // serializes writers
+)
+
+// ErrFieldTableFull reports more distinct field names than compact storage can tell apart
+var ErrFieldTableFull = errors.New("unihan: too many distinct field names for compact storage")
+
+func init() {
+	fieldTable.Store(&fieldNames{ids: make(map[string]uint16)})
+}
+
+// WithCompact switches the database to compact storage, see DB.Compact()
+func WithCompact() LoadOption {
+	return func(c *loadConfig) {
+		c.compact = true
+	}
+}
+
+/* {{{ [DB struct] */
+// Compact moves field values of every character into a packed arena with
+// interned field names, Properties maps are left empty and materialized on
+// demand (JSON encoding, Field() and typed accessors work the same).
+// Further loads into the database stay compact.
+//
+// Characters with field names beyond the capacity of the interned table stay
+// unpacked, ErrFieldTableFull is returned then
+func (db *DB) Compact() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.compact = true
+	err := db.packAll(nil)
+	if idx := db.index.Load(); idx != nil {
+		// Same content, republish the packed characters
+		packed := *idx
+		packed.hans = maps.Clone(db.hans)
+		db.index.Store(&packed)
+	}
+
+	return err
+}
+
+// IsCompact reports whether the database uses compact storage
+func (db *DB) IsCompact() bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.compact
+}
+
+// Pack every character, copies are packed in place of shared ones (not in fresh).
+// Characters failing to pack are left as they are, the first error is returned
+func (db *DB) packAll(fresh map[rune]*Han) error {
+	var first error
+	for codePoint, han := range db.hans {
+		if han.packed != nil {
+			continue
+		}
+
+		if fresh[codePoint] == nil {
+			han = han.clone()
+		}
+
+		err := han.pack()
+		if err != nil {
+			first = cmp.Or(first, err)
+
+			continue
+		}
+
+		db.hans[codePoint] = han
+	}
+
+	return first
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// MarshalJSON encodes packed characters with materialized Properties
+func (h *Han) MarshalJSON() ([]byte, error) {
+	type plain Han
+	if h.packed == nil {
+		return json.Marshal((*plain)(h))
+	}
+
+	c := *h
+	c.unpack()
+
+	return json.Marshal((*plain)(&c))
+}
+
+// Move Properties into packed storage, unchanged on error
+func (h *Han) pack() error {
+	if h.packed != nil {
+		return nil
+	}
+
+	p := &packedFields{}
+	var b strings.Builder
+	for i, category := range categories {
+		fields := h.categoryFields(category)
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+		for _, name := range names {
+			id, err := internField(name)
+			if err != nil {
+				return err
+			}
+
+			start := b.Len()
+			b.WriteString(strings.Join(fields[name], " "))
+			p.spans = append(p.spans, fieldSpan{
+				field:    id,
+				category: uint8(i),
+				start:    uint32(start),
+				end:      uint32(b.Len()),
+			})
+		}
+	}
+
+	p.data = b.String()
+	p.spans = slices.Clip(p.spans)
+
+	var zero Han
+	h.Properties = zero.Properties
+	h.packed = p
+
+	return nil
+}
+
+// Materialize Properties from packed storage
+func (h *Han) unpack() {
+	p := h.packed
+	if p == nil {
+		return
+	}
+
+	h.packed = nil
+	for _, span := range p.spans {
+		category := categories[span.category]
+		text := p.data[span.start:span.end]
+		values := []string{text}
+		if category != Readings {
+			values = strings.Fields(text)
+		}
+
+		h.setCategoryField(category, lookupFieldName(span.field), values)
+	}
+}
+
+/* }}} */
+
+/* {{{ [packedFields struct] */
+func (p *packedFields) categoryFields(category string) map[string][]string {
+	index := slices.Index(categories, category)
+	var fields map[string][]string
+	for _, span := range p.spans {
+		if int(span.category) != index {
+			continue
+		}
+
+		if fields == nil {
+			fields = make(map[string][]string)
+		}
+
+		text := p.data[span.start:span.end]
+		if category == Readings {
+			fields[lookupFieldName(span.field)] = []string{text}
+		} else {
+			fields[lookupFieldName(span.field)] = strings.Fields(text)
+		}
+	}
+
+	return fields
+}
+
+// Readings first, then other categories in loading order, as Han.fieldValues()
+func (p *packedFields) fieldValues(name string) []string {
+	id, ok := lookupField(name)
+	if !ok {
+		return nil
+	}
+
+	var found *fieldSpan
+	for i := range p.spans {
+		span := &p.spans[i]
+		if span.field != id {
+			continue
+		}
+
+		if categories[span.category] == Readings {
+			return strings.Fields(p.data[span.start:span.end])
+		}
+
+		if found == nil {
+			found = span
+		}
+	}
+
+	if found == nil {
+		return nil
+	}
+
+	return strings.Fields(p.data[found.start:found.end])
+}
+
+// Raw text of a field in a category
+func (p *packedFields) text(category, name string) string {
+	id, ok := lookupField(name)
+	if !ok {
+		return ""
+	}
+
+	for _, span := range p.spans {
+		if span.field == id && categories[span.category] == category {
+			return p.data[span.start:span.end]
+		}
+	}
+
+	return ""
+}
+
+/* }}} */
+
+// Interned id of a field name, ErrFieldTableFull once every id is taken
+func internField(name string) (uint16, error) {
+	id, ok := lookupField(name)
+	if ok {
+		return id, nil
+	}
+
+	fieldTableLock.Lock()
+	defer fieldTableLock.Unlock()
+
+	table := fieldTable.Load()
+	id, ok = table.ids[name]
+	if ok {
+		return id, nil
+	}
+
+	if len(table.names) > math.MaxUint16 {
+		return 0, ErrFieldTableFull
+	}
+
+	// Publish a copy, readers keep the table they loaded
+	id = uint16(len(table.names))
+	next := &fieldNames{
+		ids:   maps.Clone(table.ids),
+		names: append(slices.Clip(table.names), name),
+	}
+
+	next.ids[name] = id
+	fieldTable.Store(next)
+
+	return id, nil
+}
+
+func lookupField(name string) (uint16, bool) {
+	id, ok := fieldTable.Load().ids[name]
+
+	return id, ok
+}
+
+func lookupFieldName(id uint16) string {
+	return fieldTable.Load().names[id]
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
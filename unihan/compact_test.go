@@ -0,0 +1,100 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file compact_test.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"runtime"
+	"testing"
+)
+
+// Storage layouts compared by the benchmarks
+var benchLayouts = []struct {
+	name string
+	opts []LoadOption
+}{
+	{"map", nil},
+	{"compact", []LoadOption{WithCompact()}},
+}
+
+// Heap retained by a database loaded with opts, in bytes
+func retainedHeap(tb testing.TB, opts ...LoadOption) int64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	db := loadTestdata(tb, opts...)
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(db)
+
+	return int64(after.HeapAlloc) - int64(before.HeapAlloc)
+}
+
+// Load time and retained heap (heap-B) of the map and the compact storage
+func BenchmarkLoad(b *testing.B) {
+	for _, layout := range benchLayouts {
+		b.Run(layout.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				loadTestdata(b, layout.opts...)
+			}
+
+			b.ReportMetric(float64(retainedHeap(b, layout.opts...)), "heap-B")
+		})
+	}
+}
+
+// One pass of typical field reads over every character
+func BenchmarkFieldRead(b *testing.B) {
+	for _, layout := range benchLayouts {
+		b.Run(layout.name, func(b *testing.B) {
+			db := loadTestdata(b, layout.opts...)
+			b.ReportAllocs()
+			for b.Loop() {
+				for han := range db.All() {
+					han.Mandarin()
+					han.RadicalStroke()
+					han.TotalStrokes(LocaleG)
+					han.Field(FieldBigFive)
+				}
+			}
+		})
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,281 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file convert.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Variant fields used by conversion
+const (
+	FieldSimplifiedVariant  = "kSimplifiedVariant"
+	FieldTraditionalVariant = "kTraditionalVariant"
+)
+
+// Script is the target of simplified / traditional conversion
+type Script int
+
+const (
+	ScriptSimplified Script = iota
+	ScriptTraditional
+)
+
+// PhraseTable resolves one-to-many mappings by context
+type PhraseTable interface {
+	// Match returns the longest known phrase at the beginning of text and its conversion
+	Match(text string) (phrase, converted string, ok bool)
+}
+
+// Phrases is a longest-match PhraseTable held in memory
+type Phrases struct {
+	phrases map[string]string
+	maxLen  int
+}
+
+// Ambiguity is a character converted from a one-to-many mapping without phrase context
+type Ambiguity struct {
+	Offset     int      `json:"offset"` // Byte offset in the source text
+	Source     string   `json:"source"`
+	Candidates []string `json:"candidates"`
+	Chosen     string   `json:"chosen"`
+}
+
+// Converter converts text between simplified and traditional Chinese
+type Converter struct {
+	db      *DB
+	field   string
+	phrases PhraseTable
+}
+
+// NewPhrases creates an empty phrase table
+func NewPhrases() *Phrases {
+	return &Phrases{
+		phrases: make(map[string]string),
+	}
+}
+
+// LoadPhrases reads a phrase table, one "phrase<TAB>conversion" per line.
+// Only the first conversion is used if several are listed separated by spaces,
+// lines without conversion fail with a *ParseError
+func LoadPhrases(r io.Reader) (*Phrases, error) {
+	p := NewPhrases()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		phrase, converted := cutField(text)
+		converted, _ = cutField(converted)
+		if converted == "" {
+			return nil, &ParseError{Line: line, Reason: fmt.Sprintf("missing conversion of %q", phrase)}
+		}
+
+		p.Add(phrase, converted)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ToSimplified converts text to simplified Chinese by the default database
+func ToSimplified(text string) string {
+	return defaultDB.ToSimplified(text)
+}
+
+// ToTraditional converts text to traditional Chinese by the default database
+func ToTraditional(text string) string {
+	return defaultDB.ToTraditional(text)
+}
+
+/* {{{ [Phrases struct] */
+// Add registers a phrase and its conversion
+func (p *Phrases) Add(phrase, converted string) {
+	p.phrases[phrase] = converted
+	if n := utf8.RuneCountInString(phrase); n > p.maxLen {
+		p.maxLen = n
+	}
+}
+
+// Match implements PhraseTable
+func (p *Phrases) Match(text string) (string, string, bool) {
+	// Byte offsets of the first maxLen runes
+	ends := make([]int, 0, p.maxLen)
+	for i, r := range text {
+		if len(ends) == p.maxLen {
+			break
+		}
+
+		ends = append(ends, i+utf8.RuneLen(r))
+	}
+
+	for n := len(ends); n > 0; n-- {
+		phrase := text[:ends[n-1]]
+		if converted, ok := p.phrases[phrase]; ok {
+			return phrase, converted, true
+		}
+	}
+
+	return "", "", false
+}
+
+/* }}} */
+
+/* {{{ [DB struct] */
+// NewConverter creates a converter to the target script, phrases may be nil
+func (db *DB) NewConverter(to Script, phrases PhraseTable) *Converter {
+	c := &Converter{
+		db:      db,
+		field:   FieldSimplifiedVariant,
+		phrases: phrases,
+	}
+
+	if to == ScriptTraditional {
+		c.field = FieldTraditionalVariant
+	}
+
+	return c
+}
+
+// ToSimplified converts text to simplified Chinese, one-to-many mappings take the first candidate
+func (db *DB) ToSimplified(text string) string {
+	converted, _ := db.NewConverter(ScriptSimplified, nil).Convert(text)
+
+	return converted
+}
+
+// ToTraditional converts text to traditional Chinese, one-to-many mappings take the first candidate
+func (db *DB) ToTraditional(text string) string {
+	converted, _ := db.NewConverter(ScriptTraditional, nil).Convert(text)
+
+	return converted
+}
+
+/* }}} */
+
+/* {{{ [Converter struct] */
+// Convert converts text, reporting characters with several candidates that
+// were not resolved by the phrase table
+func (c *Converter) Convert(text string) (string, []Ambiguity) {
+	var (
+		sb          strings.Builder
+		ambiguities []Ambiguity
+	)
+
+	for i := 0; i < len(text); {
+		if c.phrases != nil {
+			phrase, converted, ok := c.phrases.Match(text[i:])
+			if ok {
+				sb.WriteString(converted)
+				i += len(phrase)
+
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+		candidates := c.candidates(r)
+		switch len(candidates) {
+		case 0:
+			sb.WriteString(text[i : i+size])
+		case 1:
+			sb.WriteRune(candidates[0])
+		default:
+			ambiguity := Ambiguity{
+				Offset: i,
+				Source: string(r),
+				Chosen: string(candidates[0]),
+			}
+
+			for _, candidate := range candidates {
+				ambiguity.Candidates = append(ambiguity.Candidates, string(candidate))
+			}
+
+			ambiguities = append(ambiguities, ambiguity)
+			sb.WriteRune(candidates[0])
+		}
+
+		i += size
+	}
+
+	return sb.String(), ambiguities
+}
+
+// Distinct mapping targets of a character
+func (c *Converter) candidates(r rune) []rune {
+	han := c.db.GetHanByCodePoint(r)
+	if han == nil {
+		return nil
+	}
+
+	var candidates []rune
+	for _, codePoint := range variantCodePoints(han.fieldValues(c.field)) {
+		if !slices.Contains(candidates, codePoint) {
+			candidates = append(candidates, codePoint)
+		}
+	}
+
+	return candidates
+}
+
+/* }}} */
+
+// Code points of variant values, source annotations ("U+5B78<kMatthews") are dropped
+func variantCodePoints(values []string) []rune {
+	var codePoints []rune
+	for _, value := range values {
+		code, _, _ := strings.Cut(value, "<")
+		codePoint, err := ParseUnicode(code)
+		if err == nil {
+			codePoints = append(codePoints, codePoint)
+		}
+	}
+
+	return codePoints
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -33,7 +33,11 @@ package unihan
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Structs
@@ -55,27 +59,123 @@ type Han struct {
 		Variants            map[string][]string `json:"variants"`
 		NumericValues       map[string][]string `json:"numeric_values"`
 	} `json:"properties"`
+	Count int64    `json:"count,omitempty"` // Occurrences in external corpora, see LoadFrequency()
+	IDS   []string `json:"ids,omitempty"`   // Ideographic description sequences, see LoadIDS()
+
+	db     *DB
+	packed *packedFields // Compact storage replacing Properties, see DB.Compact()
+}
+
+// DB holds one loaded copy of the unihan database
+type DB struct {
+	lock    *sync.RWMutex
+	hans    map[rune]*Han
+	index   atomic.Pointer[index] // replaced as a whole, readable without lock
+	loaded  map[string]bool       // categories
+	headers map[string]FileHeader // by category
+	compact bool
+	config  loadConfig // of the latest load, reused by Reload()
+
+	generation atomic.Uint64 // content changes, see Generation()
 }
 
 var (
 	Database     = make(map[rune]*Han)
 	DatabaseLock sync.RWMutex
+
+	// Default instance, shared with the package-level Database / DatabaseLock
+	defaultDB = &DB{
+		lock: &DatabaseLock,
+		hans: Database,
+	}
 )
 
+// New creates an empty database
+func New() *DB {
+	return &DB{
+		lock: new(sync.RWMutex),
+		hans: make(map[rune]*Han),
+	}
+}
+
+// Open creates a database and loads unihan source files from path
+func Open(path string, opts ...LoadOption) (*DB, error) {
+	db := New()
+	err := db.Load(path, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// Default returns the package-level database used by the global functions
+func Default() *DB {
+	return defaultDB
+}
+
 func DumpDatabase() {
-	DatabaseLock.Lock()
-	defer DatabaseLock.Unlock()
+	defaultDB.Dump()
+}
 
-	b, _ := json.MarshalIndent(Database, "", "  ")
+func CountDatabase() int {
+	return defaultDB.Count()
+}
+
+/* {{{ [DB struct] */
+func (db *DB) Dump() {
+	b, _ := json.MarshalIndent(db.view(), "", "  ")
 
 	fmt.Println(string(b))
 }
 
-func CountDatabase() int {
-	DatabaseLock.Lock()
-	defer DatabaseLock.Unlock()
+func (db *DB) Count() int {
+	if idx := db.index.Load(); idx != nil {
+		return len(idx.hans)
+	}
 
-	return len(Database)
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return len(db.hans)
+}
+
+// Character of the published indexes, lock free. The live map is read under
+// the read lock until the first load indexed the database (filled directly
+// through the Database variable)
+func (db *DB) lookup(codePoint rune) *Han {
+	if idx := db.index.Load(); idx != nil {
+		return idx.hans[codePoint]
+	}
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.hans[codePoint]
+}
+
+// Character table for iteration, the immutable copy of the published indexes
+// or a copy of the live map, see lookup()
+func (db *DB) view() map[rune]*Han {
+	if idx := db.index.Load(); idx != nil {
+		return idx.hans
+	}
+
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return maps.Clone(db.hans)
+}
+
+/* }}} */
+
+func newHan(db *DB, codePoint rune) *Han {
+	return &Han{
+		CodePoint: codePoint,
+		Unicode:   fmt.Sprintf("U+%04X", codePoint),
+		Value:     string(codePoint),
+		db:        db,
+	}
 }
 
 /* {{{ [Han struct] */
@@ -85,6 +185,184 @@ func (h *Han) Dump() string {
 	return string(b)
 }
 
+// Copy to modify while readers may still hold the original, packed values are shared
+func (h *Han) clone() *Han {
+	c := *h
+	c.IDS = slices.Clone(h.IDS)
+	for _, fields := range []*map[string][]string{
+		&c.Properties.DictionaryIndices,
+		&c.Properties.DictionaryLikeData,
+		&c.Properties.IRGSources,
+		&c.Properties.NumericValues,
+		&c.Properties.OtherMappings,
+		&c.Properties.RadicalStrokeCounts,
+		&c.Properties.Variants,
+	} {
+		if *fields != nil {
+			copied := make(map[string][]string, len(*fields))
+			for name, values := range *fields {
+				copied[name] = slices.Clip(values)
+			}
+
+			*fields = copied
+		}
+	}
+
+	c.Properties.Readings = maps.Clone(h.Properties.Readings)
+
+	return &c
+}
+
+// Field values of one category (source file), readings are wrapped into single values
+func (h *Han) categoryFields(category string) map[string][]string {
+	if h.packed != nil {
+		return h.packed.categoryFields(category)
+	}
+
+	switch category {
+	case DictionaryIndices:
+		return h.Properties.DictionaryIndices
+	case DictionaryLikeData:
+		return h.Properties.DictionaryLikeData
+	case IRGSources:
+		return h.Properties.IRGSources
+	case NumericValues:
+		return h.Properties.NumericValues
+	case OtherMappings:
+		return h.Properties.OtherMappings
+	case RadicalStrokeCounts:
+		return h.Properties.RadicalStrokeCounts
+	case Variants:
+		return h.Properties.Variants
+	case Readings:
+		if h.Properties.Readings == nil {
+			return nil
+		}
+
+		fields := make(map[string][]string, len(h.Properties.Readings))
+		for name, value := range h.Properties.Readings {
+			fields[name] = []string{value}
+		}
+
+		return fields
+	}
+
+	return nil
+}
+
+// Field returns values of a field (kMandarin, kRSUnicode ...) from whichever
+// source file it was loaded in, nil if absent
+func (h *Han) Field(name string) []string {
+	return h.fieldValues(name)
+}
+
+// Whole text of a reading field, such as kDefinition
+func (h *Han) reading(name string) string {
+	if h.packed != nil {
+		return h.packed.text(Readings, name)
+	}
+
+	return h.Properties.Readings[name]
+}
+
+// Values of a field from whichever category it was loaded in,
+// fields such as kRSUnicode moved between files across unicode versions
+func (h *Han) fieldValues(name string) []string {
+	if h.packed != nil {
+		return h.packed.fieldValues(name)
+	}
+
+	if value, ok := h.Properties.Readings[name]; ok {
+		return strings.Fields(value)
+	}
+
+	for _, category := range categories {
+		if category == Readings {
+			continue
+		}
+
+		if values, ok := h.categoryFields(category)[name]; ok {
+			return values
+		}
+	}
+
+	return nil
+}
+
+// Append a raw value read from source file, readings keep the whole text (definitions etc.)
+func (h *Han) addCategoryField(category, name, value string) {
+	if category == Readings {
+		if h.Properties.Readings == nil {
+			h.Properties.Readings = make(map[string]string)
+		}
+
+		if prev, ok := h.Properties.Readings[name]; ok {
+			value = prev + " " + value
+		}
+
+		h.Properties.Readings[name] = value
+
+		return
+	}
+
+	fields := h.categoryFields(category)
+	h.setCategoryField(category, name, append(fields[name], strings.Fields(value)...))
+}
+
+// Remove fields of one category, fields nil for all
+func (h *Han) clearCategoryFields(category string, fields map[string]bool) {
+	if category == Readings {
+		for name := range h.Properties.Readings {
+			if fields == nil || fields[name] {
+				delete(h.Properties.Readings, name)
+			}
+		}
+
+		return
+	}
+
+	// Maps of other categories are shared, not copies
+	values := h.categoryFields(category)
+	for name := range values {
+		if fields == nil || fields[name] {
+			delete(values, name)
+		}
+	}
+}
+
+func (h *Han) setCategoryField(category, name string, values []string) {
+	set := func(fields *map[string][]string) {
+		if *fields == nil {
+			*fields = make(map[string][]string)
+		}
+
+		(*fields)[name] = values
+	}
+
+	switch category {
+	case DictionaryIndices:
+		set(&h.Properties.DictionaryIndices)
+	case DictionaryLikeData:
+		set(&h.Properties.DictionaryLikeData)
+	case IRGSources:
+		set(&h.Properties.IRGSources)
+	case NumericValues:
+		set(&h.Properties.NumericValues)
+	case OtherMappings:
+		set(&h.Properties.OtherMappings)
+	case RadicalStrokeCounts:
+		set(&h.Properties.RadicalStrokeCounts)
+	case Variants:
+		set(&h.Properties.Variants)
+	case Readings:
+		if h.Properties.Readings == nil {
+			h.Properties.Readings = make(map[string]string)
+		}
+
+		h.Properties.Readings[name] = strings.Join(values, " ")
+	}
+}
+
 /* }}} */
 
 /*
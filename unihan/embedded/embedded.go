@@ -0,0 +1,105 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file embedded.go
+ * @package embedded
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+// Package embedded carries a prebuilt unihan snapshot inside the binary, so
+// services get the full Han table without any data directory.
+//
+// The snapshot is not part of the module, it is generated in a checkout (or a
+// vendored / replaced copy) of the module from the unihan source files, a
+// directory or the official Unihan.zip :
+//
+//	UNIHAN_SRC=/path/to/Unihan.zip go generate ./unihan/embedded
+//
+// and compiled in with the unihan_embed build tag :
+//
+//	go build -tags unihan_embed
+//
+// Without the tag the package builds with no snapshot, Available() is false.
+// Services depending on the module read-only can embed a snapshot of their own
+// and pass it to unihan.ReadSnapshot() instead
+package embedded
+
+//go:generate go run gen.go -src=${UNIHAN_SRC} -out=unihan.snap.gz
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+
+	"github.com/drnp/go-xuan/unihan"
+)
+
+var ErrNotEmbedded = errors.New("embedded: unihan snapshot not compiled in, build with -tags unihan_embed")
+
+// Available reports whether a snapshot was compiled into the binary
+func Available() bool {
+	return len(snapshot) > 0
+}
+
+// Load reads the embedded snapshot into the default unihan database
+func Load() error {
+	return LoadInto(unihan.Default())
+}
+
+// Open creates a new database from the embedded snapshot
+func Open() (*unihan.DB, error) {
+	db := unihan.New()
+	err := LoadInto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// LoadInto reads the embedded snapshot into db
+func LoadInto(db *unihan.DB) error {
+	if !Available() {
+		return ErrNotEmbedded
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(snapshot))
+	if err != nil {
+		return err
+	}
+
+	defer r.Close()
+
+	return db.ReadSnapshot(r)
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,96 @@
+//go:build ignore
+
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file gen.go
+ * @package main
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+// Generates the embedded unihan snapshot from the unihan source files
+// (a directory or the official Unihan.zip)
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/drnp/go-xuan/unihan"
+)
+
+func main() {
+	src := flag.String("src", "", "unihan source directory or zip archive (UNIHAN_SRC of go generate)")
+	out := flag.String("out", "unihan.snap.gz", "snapshot output file")
+	flag.Parse()
+
+	if *src == "" {
+		log.Fatal("no unihan source, set UNIHAN_SRC to the source directory or Unihan.zip")
+	}
+
+	db := unihan.New()
+	var err error
+	if strings.HasSuffix(strings.ToLower(*src), ".zip") {
+		err = db.LoadZip(*src)
+	} else {
+		err = db.Load(*src)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer f.Close()
+
+	w, _ := gzip.NewWriterLevel(f, gzip.BestCompression)
+	err = db.WriteSnapshot(w)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Snapshot written :", *out, "characters :", db.Count())
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,231 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file frequency.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// LoadFrequency merges a corpus frequency list into the default database
+func LoadFrequency(r io.Reader) error {
+	return defaultDB.LoadFrequency(r)
+}
+
+// MostCommon returns up to n most common characters of the default database
+func MostCommon(n int, filter func(*Han) bool) []*Han {
+	return defaultDB.MostCommon(n, filter)
+}
+
+/* {{{ [DB struct] */
+// LoadFrequency merges a corpus frequency list, one "char<TAB>count" per line.
+// Characters are given as themselves or as U+XXXX, counts of repeated or
+// multiple lists are added up, characters not in the database are ignored
+func (db *DB) LoadFrequency(r io.Reader) error {
+	counts := make(map[rune]int64)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		char, count := cutField(text)
+		count, _ = cutField(count)
+		codePoint, err := parseFrequencyChar(char)
+		if err != nil {
+			return &ParseError{Line: line, Reason: err.Error()}
+		}
+
+		n, err := strconv.ParseInt(count, 10, 64)
+		if err != nil || n < 0 {
+			return &ParseError{Line: line, Reason: fmt.Sprintf("invalid count %q", count)}
+		}
+
+		counts[codePoint] += n
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	for codePoint, n := range counts {
+		if han := db.hans[codePoint]; han != nil {
+			han = han.clone()
+			han.Count += n
+			db.hans[codePoint] = han
+		}
+	}
+
+	db.index.Store(buildIndex(db.hans))
+	db.generation.Add(1)
+
+	return nil
+}
+
+// MostCommon returns up to n most common characters passing filter (nil for all),
+// n <= 0 for no limit
+func (db *DB) MostCommon(n int, filter func(*Han) bool) []*Han {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	var results []*Han
+	for _, codePoint := range idx.ranked {
+		han := idx.hans[codePoint]
+		if han == nil || (filter != nil && !filter(han)) {
+			continue
+		}
+
+		results = append(results, han)
+		if n > 0 && len(results) >= n {
+			break
+		}
+	}
+
+	return results
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// Frequency returns kFrequency, 1 (most frequent) to 5, or 0 if absent
+func (h *Han) Frequency() int {
+	return h.firstInt(FieldFrequency)
+}
+
+// GradeLevel returns kGradeLevel, the primary school grade (1 - 6) a character
+// is taught in Hong Kong, or 0 if absent
+func (h *Han) GradeLevel() int {
+	return h.firstInt(FieldGradeLevel)
+}
+
+// Rank returns the 1-based position of the character from the most common,
+// ordered by corpus count, then kFrequency, then kGradeLevel.
+// Characters without any of them have rank 0
+func (h *Han) Rank() int {
+	if idx := h.index(); idx != nil {
+		return idx.ranks[h.CodePoint]
+	}
+
+	return 0
+}
+
+// First value of a numeric field, 0 if absent or invalid
+func (h *Han) firstInt(name string) int {
+	values := h.fieldValues(name)
+	if len(values) == 0 {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(values[0])
+
+	return n
+}
+
+/* }}} */
+
+// Order characters with frequency data by commonness, with their 1-based ranks
+func rankHans(hans map[rune]*Han) ([]rune, map[rune]int) {
+	ranked := make([]*Han, 0)
+	for _, han := range hans {
+		if han.Count > 0 || han.Frequency() > 0 || han.GradeLevel() > 0 {
+			ranked = append(ranked, han)
+		}
+	}
+
+	slices.SortFunc(ranked, compareCommonness)
+	codePoints := make([]rune, len(ranked))
+	ranks := make(map[rune]int, len(ranked))
+	for i, han := range ranked {
+		codePoints[i] = han.CodePoint
+		ranks[han.CodePoint] = i + 1
+	}
+
+	return codePoints, ranks
+}
+
+// More common first, missing values sort last
+func compareCommonness(a, b *Han) int {
+	return cmp.Or(
+		cmp.Compare(b.Count, a.Count),
+		compareRanked(a.Frequency(), b.Frequency()),
+		compareRanked(a.GradeLevel(), b.GradeLevel()),
+		cmp.Compare(a.CodePoint, b.CodePoint),
+	)
+}
+
+// Ascending with 0 (absent) last
+func compareRanked(a, b int) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 1
+	case b == 0:
+		return -1
+	}
+
+	return cmp.Compare(a, b)
+}
+
+// Character column of a frequency list
+func parseFrequencyChar(s string) (rune, error) {
+	if strings.HasPrefix(s, "U+") {
+		return ParseUnicode(s)
+	}
+
+	codePoint, size := utf8.DecodeRuneInString(s)
+	if codePoint == utf8.RuneError || size != len(s) {
+		return 0, fmt.Errorf("invalid character %q", s)
+	}
+
+	return codePoint, nil
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,382 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file ids.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// IDSNode is one node of an Ideographic Description Sequence, either an
+// operator with its operands or a component leaf
+type IDSNode struct {
+	Operator  rune       `json:"operator,omitempty"`  // ⿰ ⿱ ..., 0 for leaves
+	Component rune       `json:"component,omitempty"` // Encoded component, 0 for operators and unencoded ones
+	Name      string     `json:"name,omitempty"`      // Unencoded component as written, {1} or &CDP-8B7C;
+	Children  []*IDSNode `json:"children,omitempty"`
+}
+
+// LoadIDS attaches IDS data to the default database, see DB.LoadIDS()
+func LoadIDS(r io.Reader) error {
+	return defaultDB.LoadIDS(r)
+}
+
+// ContainingComponent returns characters of the default database containing a component
+func ContainingComponent(component rune) []rune {
+	return defaultDB.ContainingComponent(component)
+}
+
+// ParseIDS parses one description sequence, source annotations "^...$(GTJ)" or
+// "...[GTJ]" are ignored
+func ParseIDS(s string) (*IDSNode, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "^")
+	if i := strings.Index(s, "$"); i >= 0 {
+		s = s[:i]
+	}
+
+	if i := strings.Index(s, "["); i > 0 {
+		s = s[:i]
+	}
+
+	node, rest, err := parseIDSNode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if rest != "" {
+		return nil, fmt.Errorf("invalid IDS %q: trailing %q", s, rest)
+	}
+
+	return node, nil
+}
+
+/* {{{ [DB struct] */
+// LoadIDS attaches IDS data in the common ids.txt format, one character per line :
+//
+//	U+6797	林	⿰木木
+//
+// with one or more sequences in further columns. Lines starting with # or ;
+// are comments, characters not in the database are ignored, so load the unihan
+// source files first
+func (db *DB) LoadIDS(r io.Reader) error {
+	sequences := make(map[rune][]string)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		columns := strings.Split(text, "\t")
+		if len(columns) < 3 {
+			return &ParseError{Line: line, Reason: "expected code point, character and IDS"}
+		}
+
+		codePoint, err := ParseUnicode(columns[0])
+		if err != nil {
+			return &ParseError{Line: line, Reason: err.Error()}
+		}
+
+		for _, column := range columns[2:] {
+			node, err := ParseIDS(column)
+			if err != nil {
+				return &ParseError{Line: line, Reason: err.Error()}
+			}
+
+			// A character described by itself is atomic
+			if node.Operator == 0 && node.Component == codePoint {
+				continue
+			}
+
+			sequences[codePoint] = append(sequences[codePoint], node.String())
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	for codePoint, values := range sequences {
+		if han := db.hans[codePoint]; han != nil {
+			han = han.clone()
+			han.IDS = values
+			db.hans[codePoint] = han
+		}
+	}
+
+	db.index.Store(buildIndex(db.hans))
+	db.generation.Add(1)
+
+	return nil
+}
+
+// ContainingComponent returns characters containing a component at any depth,
+// ordered by code point. Radical variant forms are equivalent (氵 and 水), and
+// characters indexed under the radical of the component are included
+func (db *DB) ContainingComponent(component rune) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	forms := []rune{normalizeComponent(component)}
+	radical := ComponentRadical(forms[0])
+	if radical != nil {
+		forms = append(forms, radical.Glyph)
+		if radical.Simplified != 0 {
+			forms = append(forms, radical.Simplified)
+		}
+
+		for form, number := range RadicalForms {
+			if number == radical.Number {
+				forms = append(forms, form)
+			}
+		}
+	}
+
+	var codePoints []rune
+	for _, form := range forms {
+		codePoints = append(codePoints, idx.containing[form]...)
+	}
+
+	if radical != nil {
+		codePoints = append(codePoints, idx.radicals[radical.Number]...)
+	}
+
+	slices.Sort(codePoints)
+	codePoints = slices.Compact(codePoints)
+
+	return slices.DeleteFunc(codePoints, func(codePoint rune) bool {
+		return codePoint == forms[0]
+	})
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// Decompose returns the first description sequence, nil if none was loaded or
+// the character is atomic
+func (h *Han) Decompose() *IDSNode {
+	if len(h.IDS) == 0 {
+		return nil
+	}
+
+	node, _ := ParseIDS(h.IDS[0])
+
+	return node
+}
+
+// Components returns encoded components of every depth, from the outer
+// decomposition inwards, without repetition
+func (h *Han) Components() []rune {
+	if idx := h.index(); idx != nil {
+		return slices.Clone(idx.components[h.CodePoint])
+	}
+
+	return nil
+}
+
+/* }}} */
+
+/* {{{ [IDSNode struct] */
+// String returns the sequence in IDS notation
+func (n *IDSNode) String() string {
+	if n.Operator == 0 {
+		if n.Component != 0 {
+			return string(n.Component)
+		}
+
+		return n.Name
+	}
+
+	var b strings.Builder
+	b.WriteRune(n.Operator)
+	for _, child := range n.Children {
+		b.WriteString(child.String())
+	}
+
+	return b.String()
+}
+
+// Leaves returns encoded components of the sequence, left to right
+func (n *IDSNode) Leaves() []rune {
+	if n.Operator == 0 {
+		if n.Component != 0 {
+			return []rune{n.Component}
+		}
+
+		return nil
+	}
+
+	var leaves []rune
+	for _, child := range n.Children {
+		leaves = append(leaves, child.Leaves()...)
+	}
+
+	return leaves
+}
+
+/* }}} */
+
+// Number of operands of an ideographic description character, 0 if not one
+func idsArity(r rune) int {
+	switch {
+	case r == 0x2FF2 || r == 0x2FF3:
+		return 3
+	case r == 0x2FFE || r == 0x2FFF:
+		return 1
+	case r >= 0x2FF0 && r <= 0x2FFD, r == 0x31EF:
+		return 2
+	}
+
+	return 0
+}
+
+// Parse a node from the head of s, returning the rest
+func parseIDSNode(s string) (*IDSNode, string, error) {
+	if s == "" {
+		return nil, "", fmt.Errorf("invalid IDS: missing operand")
+	}
+
+	for _, pair := range [][2]string{{"{", "}"}, {"&", ";"}} {
+		if strings.HasPrefix(s, pair[0]) {
+			end := strings.Index(s, pair[1])
+			if end < 0 {
+				return nil, "", fmt.Errorf("invalid IDS: unterminated %q", s)
+			}
+
+			return &IDSNode{Name: s[:end+1]}, s[end+1:], nil
+		}
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return nil, "", fmt.Errorf("invalid IDS: bad encoding")
+	}
+
+	arity := idsArity(r)
+	if arity == 0 {
+		return &IDSNode{Component: normalizeComponent(r)}, s[size:], nil
+	}
+
+	node := &IDSNode{Operator: r}
+	rest := s[size:]
+	for range arity {
+		child, next, err := parseIDSNode(rest)
+		if err != nil {
+			return nil, "", err
+		}
+
+		node.Children = append(node.Children, child)
+		rest = next
+	}
+
+	return node, rest, nil
+}
+
+// Kangxi Radicals block characters stand for their unified ideographs
+func normalizeComponent(r rune) rune {
+	if r >= 0x2F00 && r <= 0x2FD5 {
+		return KangxiRadicals[r-0x2F00].Glyph
+	}
+
+	return r
+}
+
+// Resolve recursive components of every character, and the reverse lookup
+func indexComponents(hans map[rune]*Han) (map[rune][]rune, map[rune][]rune) {
+	containing := make(map[rune][]rune)
+	done := make(map[rune][]rune)
+	visiting := make(map[rune]bool)
+
+	var resolve func(codePoint rune) []rune
+	resolve = func(codePoint rune) []rune {
+		if components, ok := done[codePoint]; ok {
+			return components
+		}
+
+		han := hans[codePoint]
+		if han == nil || visiting[codePoint] {
+			return nil
+		}
+
+		visiting[codePoint] = true
+		var components []rune
+		if node := han.Decompose(); node != nil {
+			for _, leaf := range node.Leaves() {
+				for _, component := range append([]rune{leaf}, resolve(leaf)...) {
+					if component != codePoint && !slices.Contains(components, component) {
+						components = append(components, component)
+					}
+				}
+			}
+		}
+
+		delete(visiting, codePoint)
+		done[codePoint] = components
+
+		return components
+	}
+
+	for codePoint := range hans {
+		for _, component := range resolve(codePoint) {
+			containing[component] = append(containing[component], codePoint)
+		}
+	}
+
+	for _, codePoints := range containing {
+		slices.Sort(codePoints)
+	}
+
+	for codePoint, components := range done {
+		if len(components) == 0 {
+			delete(done, codePoint)
+		}
+	}
+
+	return done, containing
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
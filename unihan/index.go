@@ -0,0 +1,319 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file index.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Dictionary index fields
+const (
+	FieldHanYu  = "kHanYu"
+	FieldKangXi = "kKangXi"
+)
+
+// Reverse indexes built at load time, every list is ordered by code point.
+// An index is never modified once published, so readers need no lock
+type index struct {
+	hans          map[rune]*Han // copy of the database map
+	pinyin        map[Pinyin][]rune
+	radicals      map[int][]rune
+	radicalStroke map[[2]int][]rune
+	strokes       map[int][]rune
+	kangXi        map[int][]rune
+	hanYu         map[int][]rune // volume * 10000 + page
+	ranked        []rune         // by commonness, see Rank()
+	ranks         map[rune]int
+	components    map[rune][]rune // recursive, see Components()
+	containing    map[rune][]rune
+	fourCorner    []codeEntry // by code, see FindByFourCorner()
+	cangjie       []codeEntry
+}
+
+func buildIndex(hans map[rune]*Han) *index {
+	idx := &index{
+		hans:          maps.Clone(hans),
+		pinyin:        make(map[Pinyin][]rune),
+		radicals:      make(map[int][]rune),
+		radicalStroke: make(map[[2]int][]rune),
+		strokes:       make(map[int][]rune),
+		kangXi:        make(map[int][]rune),
+		hanYu:         make(map[int][]rune),
+	}
+
+	for codePoint, han := range hans {
+		for _, p := range han.PinyinReadings() {
+			idx.pinyin[p] = append(idx.pinyin[p], codePoint)
+		}
+
+		for _, rs := range han.RadicalStroke() {
+			appendUnique(idx.radicals, rs.Radical, codePoint)
+			appendUnique(idx.radicalStroke, [2]int{rs.Radical, rs.Residual}, codePoint)
+		}
+
+		for _, locale := range []Locale{LocaleG, LocaleT} {
+			if n := han.TotalStrokes(locale); n > 0 {
+				appendUnique(idx.strokes, n, codePoint)
+			}
+		}
+
+		for _, value := range han.fieldValues(FieldKangXi) {
+			page, _, _ := strings.Cut(value, ".")
+			if isDigits(page) {
+				appendUnique(idx.kangXi, atoi(page), codePoint)
+			}
+		}
+
+		for _, value := range han.fieldValues(FieldHanYu) {
+			location, err := ParseHanyuLocation(value)
+			if err == nil {
+				appendUnique(idx.hanYu, location.Volume*10000+location.Page, codePoint)
+			}
+		}
+	}
+
+	for _, m := range []map[int][]rune{idx.radicals, idx.strokes, idx.kangXi, idx.hanYu} {
+		for _, codePoints := range m {
+			slices.Sort(codePoints)
+		}
+	}
+
+	for _, codePoints := range idx.pinyin {
+		slices.Sort(codePoints)
+	}
+
+	for _, codePoints := range idx.radicalStroke {
+		slices.Sort(codePoints)
+	}
+
+	idx.ranked, idx.ranks = rankHans(hans)
+	idx.components, idx.containing = indexComponents(hans)
+	idx.fourCorner = indexCodes(hans, func(han *Han) []string {
+		var codes []string
+		for _, f := range han.FourCorner() {
+			codes = append(codes, f.Digits())
+		}
+
+		return codes
+	})
+	idx.cangjie = indexCodes(hans, func(han *Han) []string {
+		var codes []string
+		for _, c := range han.Cangjie() {
+			codes = append(codes, string(c))
+		}
+
+		return codes
+	})
+
+	return idx
+}
+
+// FindByPinyin looks up characters of the default database by Mandarin reading
+func FindByPinyin(syllable string, tone int) []rune {
+	return defaultDB.FindByPinyin(syllable, tone)
+}
+
+// FindByRadical looks up characters of the default database by Kangxi radical and residual strokes
+func FindByRadical(radical, residual int) []rune {
+	return defaultDB.FindByRadical(radical, residual)
+}
+
+// FindByTotalStrokes looks up characters of the default database by total strokes
+func FindByTotalStrokes(strokes int) []rune {
+	return defaultDB.FindByTotalStrokes(strokes)
+}
+
+// FindByKangXiPage looks up characters of the default database by Kangxi Dictionary page
+func FindByKangXiPage(page int) []rune {
+	return defaultDB.FindByKangXiPage(page)
+}
+
+// FindByHanYuPage looks up characters of the default database by Hanyu Da Zidian volume and page
+func FindByHanYuPage(volume, page int) []rune {
+	return defaultDB.FindByHanYuPage(volume, page)
+}
+
+/* {{{ [Han struct] */
+// Current indexes of the owning database, nil if detached or not indexed yet
+func (h *Han) index() *index {
+	if h.db == nil {
+		return nil
+	}
+
+	return h.db.index.Load()
+}
+
+// PinyinReadings returns distinct Mandarin readings of every reading field,
+// kMandarin ones first
+func (h *Han) PinyinReadings() []Pinyin {
+	readings := h.Mandarin()
+	add := func(values []Pinyin) {
+		for _, p := range values {
+			if !slices.Contains(readings, p) {
+				readings = append(readings, p)
+			}
+		}
+	}
+
+	for _, entry := range h.HanyuPinyin() {
+		add(entry.Readings)
+	}
+
+	for _, entry := range h.TGHZ2013() {
+		add(entry.Readings)
+	}
+
+	for _, entry := range h.XHC1983() {
+		add(entry.Readings)
+	}
+
+	for _, reading := range h.HanyuPinlu() {
+		add([]Pinyin{reading.Pinyin})
+	}
+
+	return readings
+}
+
+/* }}} */
+
+/* {{{ [DB struct] */
+// FindByPinyin looks up characters by Mandarin reading, syllable may be tone marked
+// or numbered ("huáng", "huang2", "ma5"), otherwise tone selects 1 - 4 or ToneNeutral,
+// 0 for any
+func (db *DB) FindByPinyin(syllable string, tone int) []rune {
+	p, err := ParsePinyin(syllable)
+	if err != nil {
+		return nil
+	}
+
+	if tone == 0 && (p.Tone != ToneNeutral || explicitNeutral(syllable)) {
+		tone = p.Tone
+	}
+
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	if tone > 0 {
+		return slices.Clone(idx.pinyin[Pinyin{Syllable: p.Syllable, Tone: tone}])
+	}
+
+	var codePoints []rune
+	for t := 1; t <= ToneNeutral; t++ {
+		codePoints = append(codePoints, idx.pinyin[Pinyin{Syllable: p.Syllable, Tone: t}]...)
+	}
+
+	slices.Sort(codePoints)
+
+	return slices.Compact(codePoints)
+}
+
+// FindByRadical looks up characters by Kangxi radical (kRSUnicode, simplified forms included)
+// and residual strokes, negative residual for any
+func (db *DB) FindByRadical(radical, residual int) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	if residual < 0 {
+		return slices.Clone(idx.radicals[radical])
+	}
+
+	return slices.Clone(idx.radicalStroke[[2]int{radical, residual}])
+}
+
+// FindByTotalStrokes looks up characters by kTotalStrokes, G and T values both match
+func (db *DB) FindByTotalStrokes(strokes int) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	return slices.Clone(idx.strokes[strokes])
+}
+
+// FindByKangXiPage looks up characters by kKangXi page
+func (db *DB) FindByKangXiPage(page int) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	return slices.Clone(idx.kangXi[page])
+}
+
+// FindByHanYuPage looks up characters by kHanYu volume and page, page 0 for the whole volume
+func (db *DB) FindByHanYuPage(volume, page int) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	if page > 0 {
+		return slices.Clone(idx.hanYu[volume*10000+page])
+	}
+
+	var codePoints []rune
+	for key, values := range idx.hanYu {
+		if key/10000 == volume {
+			codePoints = append(codePoints, values...)
+		}
+	}
+
+	slices.Sort(codePoints)
+
+	return slices.Compact(codePoints)
+}
+
+/* }}} */
+
+// Append code point once, values of one character are added consecutively
+func appendUnique[K comparable](m map[K][]rune, key K, codePoint rune) {
+	codePoints := m[key]
+	if n := len(codePoints); n > 0 && codePoints[n-1] == codePoint {
+		return
+	}
+
+	m[key] = append(codePoints, codePoint)
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,277 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file inputcodes.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Lookup code fields
+const (
+	FieldCangjie        = "kCangjie"
+	FieldFourCornerCode = "kFourCornerCode"
+)
+
+// FourCorner is one kFourCornerCode value, "4480.6"
+type FourCorner struct {
+	Corners    [4]int `json:"corners"`    // Upper left, upper right, lower left, lower right
+	Supplement int    `json:"supplement"` // Fifth (附角) digit, -1 if absent
+}
+
+// Cangjie is a Cangjie input code of 1 - 5 letters A - Z, upper case
+type Cangjie string
+
+// Cangjie radical names of the letters A - Z
+var cangjieRadicals = []rune("日月金木水火土竹戈十大中一弓人心手口尸廿山女田難卜重")
+
+// Indexed lookup code, ordered by code then code point
+type codeEntry struct {
+	code      string
+	codePoint rune
+}
+
+// ParseFourCorner parses a four-corner code, "4480.6", "44806" or "4480"
+func ParseFourCorner(s string) (FourCorner, error) {
+	digits := normalizeFourCorner(s)
+	if (len(digits) != 4 && len(digits) != 5) || !isDigits(digits) {
+		return FourCorner{}, fmt.Errorf("invalid four-corner code %q", s)
+	}
+
+	f := FourCorner{Supplement: -1}
+	for i := range f.Corners {
+		f.Corners[i] = int(digits[i] - '0')
+	}
+
+	if len(digits) == 5 {
+		f.Supplement = int(digits[4] - '0')
+	}
+
+	return f, nil
+}
+
+// ParseCangjie normalizes a Cangjie code given by letters in any case or by
+// radical names, "hqi", "HQI" and "竹手戈" are the same code
+func ParseCangjie(s string) (Cangjie, error) {
+	code := normalizeCangjie(strings.TrimSpace(s))
+	if code == "" || len(code) > 5 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", fmt.Errorf("invalid cangjie code %q", s)
+	}
+
+	return Cangjie(code), nil
+}
+
+// FindByFourCorner looks up characters of the default database by four-corner code
+func FindByFourCorner(pattern string) []rune {
+	return defaultDB.FindByFourCorner(pattern)
+}
+
+// FindByCangjie looks up characters of the default database by Cangjie code
+func FindByCangjie(pattern string) []rune {
+	return defaultDB.FindByCangjie(pattern)
+}
+
+/* {{{ [DB struct] */
+// FindByFourCorner looks up characters by a full or partial four-corner code,
+// "4480.6", "448" (prefix) or "4?8" (? for any digit), ordered by code point
+func (db *DB) FindByFourCorner(pattern string) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	return findByCode(idx.fourCorner, normalizeFourCorner(pattern))
+}
+
+// FindByCangjie looks up characters by a full or partial Cangjie code in letters
+// or radical names, "HQ" (prefix) or "H?I" (? for any letter), ordered by code point
+func (db *DB) FindByCangjie(pattern string) []rune {
+	idx := db.index.Load()
+	if idx == nil {
+		return nil
+	}
+
+	return findByCode(idx.cangjie, normalizeCangjie(pattern))
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// FourCorner returns kFourCornerCode values
+func (h *Han) FourCorner() []FourCorner {
+	var values []FourCorner
+	for _, s := range h.fieldValues(FieldFourCornerCode) {
+		f, err := ParseFourCorner(s)
+		if err == nil {
+			values = append(values, f)
+		}
+	}
+
+	return values
+}
+
+// Cangjie returns kCangjie values
+func (h *Han) Cangjie() []Cangjie {
+	var values []Cangjie
+	for _, s := range h.fieldValues(FieldCangjie) {
+		c, err := ParseCangjie(s)
+		if err == nil {
+			values = append(values, c)
+		}
+	}
+
+	return values
+}
+
+/* }}} */
+
+/* {{{ [FourCorner struct] */
+// String returns the code in kFourCornerCode form, "4480.6"
+func (f FourCorner) String() string {
+	s := fmt.Sprintf("%d%d%d%d", f.Corners[0], f.Corners[1], f.Corners[2], f.Corners[3])
+	if f.Supplement >= 0 {
+		s += fmt.Sprintf(".%d", f.Supplement)
+	}
+
+	return s
+}
+
+// Digits returns the code without separator, "44806"
+func (f FourCorner) Digits() string {
+	return strings.ReplaceAll(f.String(), ".", "")
+}
+
+/* }}} */
+
+/* {{{ [Cangjie struct] */
+// Radicals returns the code by radical names, "竹手戈"
+func (c Cangjie) Radicals() string {
+	var b strings.Builder
+	for i := 0; i < len(c); i++ {
+		if c[i] >= 'A' && c[i] <= 'Z' {
+			b.WriteRune(cangjieRadicals[c[i]-'A'])
+		}
+	}
+
+	return b.String()
+}
+
+/* }}} */
+
+// Digits only, the supplement separator dropped
+func normalizeFourCorner(s string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), ".", "")
+}
+
+// Upper case letters, radical names replaced by their letters
+func normalizeCangjie(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if i := slices.Index(cangjieRadicals, r); i >= 0 {
+			b.WriteByte(byte('A' + i))
+		} else if r < utf8.RuneSelf {
+			b.WriteString(strings.ToUpper(string(r)))
+		}
+	}
+
+	return b.String()
+}
+
+// Build a sorted code list
+func indexCodes(hans map[rune]*Han, codes func(*Han) []string) []codeEntry {
+	var entries []codeEntry
+	for codePoint, han := range hans {
+		for _, code := range codes(han) {
+			entries = append(entries, codeEntry{code: code, codePoint: codePoint})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b codeEntry) int {
+		return cmp.Or(strings.Compare(a.code, b.code), cmp.Compare(a.codePoint, b.codePoint))
+	})
+
+	return entries
+}
+
+// Match codes by prefix pattern, ? for any single character
+func findByCode(entries []codeEntry, pattern string) []rune {
+	if pattern == "" {
+		return nil
+	}
+
+	// Binary search the literal head, scan the rest
+	head, _, _ := strings.Cut(pattern, "?")
+	start, _ := slices.BinarySearchFunc(entries, head, func(e codeEntry, head string) int {
+		return strings.Compare(e.code, head)
+	})
+
+	var codePoints []rune
+	for _, entry := range entries[start:] {
+		if !strings.HasPrefix(entry.code, head) {
+			break
+		}
+
+		if matchCodePattern(entry.code, pattern) {
+			codePoints = append(codePoints, entry.codePoint)
+		}
+	}
+
+	slices.Sort(codePoints)
+
+	return slices.Compact(codePoints)
+}
+
+// Whether a code starts with the pattern
+func matchCodePattern(code, pattern string) bool {
+	if len(code) < len(pattern) {
+		return false
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '?' && pattern[i] != code[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
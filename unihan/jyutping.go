@@ -0,0 +1,415 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file jyutping.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// CantoneseStyle selects how Cantonese syllables are written
+type CantoneseStyle int
+
+const (
+	StyleJyutping   CantoneseStyle = iota // jyut6
+	StyleYale                             // yuht, tone by diacritics and h
+	StyleYaleNumber                       // yut6
+)
+
+// JyutpingOptions controls Cantonese transliteration, nil for defaults
+type JyutpingOptions struct {
+	Style     CantoneseStyle
+	Heteronym bool // Output every kCantonese reading
+}
+
+// Jyutping is one Cantonese syllable in LSHK Jyutping
+type Jyutping struct {
+	Syllable string `json:"syllable"` // Toneless, lower case
+	Tone     int    `json:"tone"`     // 1 - 6
+}
+
+// Jyutping initials, longest first
+var jyutpingInitials = []string{"gw", "kw", "ng", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "w", "z", "c", "s", "j"}
+
+// Initials of Jyutping => Yale
+var yaleInitials = map[string]string{
+	"z": "j",
+	"c": "ch",
+	"j": "y",
+}
+
+// Yale tone marks of tone 1, 2, 4, 5 (combining)
+var yaleToneMarks = map[int]rune{
+	1: '\u0304',
+	2: '\u0301',
+	4: '\u0300',
+	5: '\u0301',
+}
+
+// ParseJyutping parses a tone numbered Jyutping syllable, "jyut6"
+func ParseJyutping(s string) (Jyutping, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	n := len(s)
+	if n < 2 || s[n-1] < '1' || s[n-1] > '6' {
+		return Jyutping{}, fmt.Errorf("invalid jyutping %q", s)
+	}
+
+	for i := 0; i < n-1; i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return Jyutping{}, fmt.Errorf("invalid jyutping %q", s)
+		}
+	}
+
+	return Jyutping{
+		Syllable: s[:n-1],
+		Tone:     int(s[n-1] - '0'),
+	}, nil
+}
+
+// ParseYale parses a Cantonese Yale syllable, with diacritics ("yuht") or tone number ("yut6")
+func ParseYale(s string) (Jyutping, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	invalid := fmt.Errorf("invalid yale %q", s)
+
+	// Tone numbered
+	tone := 0
+	if n := len(s); n > 1 && s[n-1] >= '1' && s[n-1] <= '6' {
+		tone = int(s[n-1] - '0')
+		s = s[:n-1]
+	}
+
+	// Diacritics
+	var (
+		sb   strings.Builder
+		mark rune
+	)
+
+	for _, r := range decomposeYale(s) {
+		switch {
+		case r == '\u0304' || r == '\u0301' || r == '\u0300':
+			mark = r
+		case r >= 'a' && r <= 'z':
+			sb.WriteRune(r)
+		default:
+			return Jyutping{}, invalid
+		}
+	}
+
+	syllable := sb.String()
+	initial, final := splitYale(syllable)
+	if final == "" {
+		return Jyutping{}, invalid
+	}
+
+	if tone == 0 {
+		// Low tones are marked by h after the vowels
+		low := false
+		nucleus := yaleNucleusEnd(final)
+		if nucleus < len(final) && final[nucleus] == 'h' {
+			final = final[:nucleus] + final[nucleus+1:]
+			low = true
+		}
+
+		switch {
+		case mark == '\u0304':
+			tone = 1
+		case mark == '\u0300' && !low:
+			// High falling, merged into tone 1
+			tone = 1
+		case mark == '\u0301' && !low:
+			tone = 2
+		case mark == 0 && !low:
+			tone = 3
+		case mark == '\u0300':
+			tone = 4
+		case mark == '\u0301':
+			tone = 5
+		default:
+			tone = 6
+		}
+	}
+
+	// Spelling back
+	switch initial {
+	case "y":
+		initial = "j"
+		if final == "u" || final == "un" || final == "ut" {
+			final = "y" + final
+		}
+	case "j":
+		initial = "z"
+	case "ch":
+		initial = "c"
+	}
+
+	switch {
+	case final == "a":
+		final = "aa"
+	case strings.HasPrefix(final, "eu"):
+		if rest := final[2:]; rest == "i" || rest == "n" || rest == "t" {
+			final = "eo" + rest
+		} else {
+			final = "oe" + rest
+		}
+	}
+
+	return ParseJyutping(fmt.Sprintf("%s%s%d", initial, final, tone))
+}
+
+// JyutpingToYale converts one Jyutping syllable to Yale
+func JyutpingToYale(s string, style CantoneseStyle) (string, error) {
+	j, err := ParseJyutping(s)
+	if err != nil {
+		return "", err
+	}
+
+	return j.Yale(style), nil
+}
+
+// YaleToJyutping converts one Yale syllable to Jyutping
+func YaleToJyutping(s string) (string, error) {
+	j, err := ParseYale(s)
+	if err != nil {
+		return "", err
+	}
+
+	return j.String(), nil
+}
+
+// ToJyutping transliterates text of the default database to Cantonese
+func ToJyutping(text string, opts *JyutpingOptions) []Segment {
+	return defaultDB.ToJyutping(text, opts)
+}
+
+// JyutpingString transliterates text of the default database to a space separated Cantonese string
+func JyutpingString(text string, opts *JyutpingOptions) string {
+	return defaultDB.JyutpingString(text, opts)
+}
+
+/* {{{ [Jyutping struct] */
+func (j Jyutping) String() string {
+	return fmt.Sprintf("%s%d", j.Syllable, j.Tone)
+}
+
+// Initial returns the initial consonant, empty for null initial and syllabic nasals
+func (j Jyutping) Initial() string {
+	initial, _ := splitJyutping(j.Syllable)
+
+	return initial
+}
+
+// Final returns the syllable without initial
+func (j Jyutping) Final() string {
+	_, final := splitJyutping(j.Syllable)
+
+	return final
+}
+
+// Yale returns the syllable in Cantonese Yale, StyleYale marks tones by diacritics and h,
+// StyleYaleNumber by tone number
+func (j Jyutping) Yale(style CantoneseStyle) string {
+	initial, final := splitJyutping(j.Syllable)
+	if y, ok := yaleInitials[initial]; ok {
+		initial = y
+	}
+
+	switch {
+	case final == "aa":
+		final = "a"
+	case strings.HasPrefix(final, "oe"), strings.HasPrefix(final, "eo"):
+		final = "eu" + final[2:]
+	}
+
+	if initial == "y" && strings.HasPrefix(final, "yu") {
+		initial = ""
+	}
+
+	if style != StyleYale {
+		return fmt.Sprintf("%s%s%d", initial, final, j.Tone)
+	}
+
+	// Diacritic on the first vowel (or syllabic nasal), h after the vowels
+	nucleus := yaleNucleusEnd(final)
+	if j.Tone >= 4 {
+		final = final[:nucleus] + "h" + final[nucleus:]
+	}
+
+	if mark, ok := yaleToneMarks[j.Tone]; ok {
+		pos := max(strings.IndexAny(final, "aeiou"), 0)
+
+		final = final[:pos+1] + string(mark) + final[pos+1:]
+	}
+
+	return composeYale(initial + final)
+}
+
+/* }}} */
+
+/* {{{ [DB struct] */
+// ToJyutping transliterates text to Cantonese by kCantonese, characters without reading are passed through
+func (db *DB) ToJyutping(text string, opts *JyutpingOptions) []Segment {
+	if opts == nil {
+		opts = &JyutpingOptions{}
+	}
+
+	var segments []Segment
+	for _, r := range text {
+		var readings []string
+		if han := db.GetHanByCodePoint(r); han != nil {
+			for _, j := range han.Cantonese() {
+				if opts.Style == StyleJyutping {
+					readings = append(readings, j.String())
+				} else {
+					readings = append(readings, j.Yale(opts.Style))
+				}
+			}
+		}
+
+		segments = appendSegment(segments, string(r), readings, opts.Heteronym)
+	}
+
+	return segments
+}
+
+// JyutpingString transliterates text to Cantonese, syllables and passed through text are separated by spaces
+func (db *DB) JyutpingString(text string, opts *JyutpingOptions) string {
+	return joinSegments(db.ToJyutping(text, opts))
+}
+
+/* }}} */
+
+func splitJyutping(syllable string) (string, string) {
+	// Syllabic nasals
+	if syllable == "m" || syllable == "ng" {
+		return "", syllable
+	}
+
+	for _, initial := range jyutpingInitials {
+		if rest, ok := strings.CutPrefix(syllable, initial); ok && rest != "" {
+			return initial, rest
+		}
+	}
+
+	return "", syllable
+}
+
+func splitYale(syllable string) (string, string) {
+	if syllable == "m" || syllable == "ng" || syllable == "mh" || syllable == "ngh" {
+		return "", syllable
+	}
+
+	for _, initial := range []string{"ch", "gw", "kw", "ng", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "w", "j", "s", "y"} {
+		if rest, ok := strings.CutPrefix(syllable, initial); ok && rest != "" {
+			return initial, rest
+		}
+	}
+
+	return "", syllable
+}
+
+// Byte offset after the vowels of a final, after the nasal of syllabic m / ng
+func yaleNucleusEnd(final string) int {
+	start := strings.IndexAny(final, "aeiou")
+	if start < 0 {
+		return len(strings.TrimSuffix(final, "h"))
+	}
+
+	end := start
+	for end < len(final) && strings.IndexByte("aeiouy", final[end]) >= 0 {
+		end++
+	}
+
+	return end
+}
+
+// Yale with combining marks => precomposed letters
+func composeYale(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if i+1 < len(runes) {
+			if composed, ok := yaleComposed[[2]rune{runes[i], runes[i+1]}]; ok {
+				sb.WriteRune(composed)
+				i++
+
+				continue
+			}
+		}
+
+		sb.WriteRune(runes[i])
+	}
+
+	return sb.String()
+}
+
+// Precomposed letters => base and combining mark
+func decomposeYale(s string) []rune {
+	var runes []rune
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		if pair, ok := yaleDecomposed[r]; ok {
+			runes = append(runes, pair[0], pair[1])
+		} else {
+			runes = append(runes, r)
+		}
+	}
+
+	return runes
+}
+
+var yaleComposed = map[[2]rune]rune{
+	{'a', '\u0304'}: 'ā', {'a', '\u0301'}: 'á', {'a', '\u0300'}: 'à',
+	{'e', '\u0304'}: 'ē', {'e', '\u0301'}: 'é', {'e', '\u0300'}: 'è',
+	{'i', '\u0304'}: 'ī', {'i', '\u0301'}: 'í', {'i', '\u0300'}: 'ì',
+	{'o', '\u0304'}: 'ō', {'o', '\u0301'}: 'ó', {'o', '\u0300'}: 'ò',
+	{'u', '\u0304'}: 'ū', {'u', '\u0301'}: 'ú', {'u', '\u0300'}: 'ù',
+	{'m', '\u0301'}: 'ḿ', {'n', '\u0301'}: 'ń', {'n', '\u0300'}: 'ǹ',
+}
+
+var yaleDecomposed = func() map[rune][2]rune {
+	m := make(map[rune][2]rune)
+	for pair, composed := range yaleComposed {
+		m[composed] = pair
+	}
+
+	return m
+}()
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -31,11 +31,14 @@
 package unihan
 
 import (
-	"bufio"
+	"archive/zip"
+	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
-	"regexp"
-	"strings"
+	"slices"
+	"sync"
 )
 
 // Unihan database files
@@ -50,461 +53,314 @@ const (
 	Variants            = "Unihan_Variants.txt"
 )
 
-// Load unihan database from source files
-func Load(path string) error {
-	abs, err := filepath.Abs(path)
-	if err != nil {
-		// File path failed
-		return err
-	}
-
-	path = filepath.Clean(abs)
-
-	err = loadDictionaryIndices(path + "/" + DictionaryIndices)
-	if err != nil {
-		return err
-	}
-
-	err = loadDictionaryLikeData(path + "/" + DictionaryLikeData)
-	if err != nil {
-		return err
-	}
-
-	err = loadIRGSources(path + "/" + IRGSources)
-	if err != nil {
-		return err
-	}
-
-	err = loadNumericValues(path + "/" + NumericValues)
-	if err != nil {
-		return err
-	}
-
-	err = loadOtherMappings(path + "/" + OtherMappings)
-	if err != nil {
-		return err
-	}
+// Source files in loading order
+var categories = []string{
+	DictionaryIndices,
+	DictionaryLikeData,
+	IRGSources,
+	NumericValues,
+	OtherMappings,
+	RadicalStrokeCounts,
+	Readings,
+	Variants,
+}
 
-	err = loadRadicalStrokeCounts(path + "/" + RadicalStrokeCounts)
-	if err != nil {
-		return err
-	}
+// Records of one source file, parsed before merging into a database
+type partialCategory struct {
+	name     string
+	header   FileHeader
+	records  []fieldRecord
+	warnings []*ParseError
+}
 
-	err = loadReadings(path + "/" + Readings)
-	if err != nil {
-		return err
-	}
+type fieldRecord struct {
+	codePoint rune
+	field     string
+	value     string
+}
 
-	err = loadVariants(path + "/" + Variants)
-	if err != nil {
-		return err
-	}
+// LoadOption narrows what a load reads, see WithCategories() and WithFields()
+type LoadOption func(*loadConfig)
 
-	return nil
+type loadConfig struct {
+	categories []string
+	fields     map[string]bool // nil for all fields
+	compact    bool
+	report     *ParseReport // nil for strict loading
 }
 
-func loadDictionaryIndices(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
+// WithCategories loads only the given source files, such as Readings and Variants
+func WithCategories(categories ...string) LoadOption {
+	return func(c *loadConfig) {
+		c.categories = append(c.categories, categories...)
 	}
+}
 
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
+// WithFields keeps only the given fields (kMandarin, kRSUnicode ...) of the loaded files
+func WithFields(fields ...string) LoadOption {
+	return func(c *loadConfig) {
+		if c.fields == nil {
+			c.fields = make(map[string]bool)
 		}
 
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
-
-				if Database[codePoint].Properties.DictionaryIndices == nil {
-					Database[codePoint].Properties.DictionaryIndices = make(map[string][]string)
-				}
-
-				Database[codePoint].Properties.DictionaryIndices[matches[2]] = append(Database[codePoint].Properties.DictionaryIndices[matches[2]], strings.Fields(matches[3])...)
-			}
+		for _, field := range fields {
+			c.fields[field] = true
 		}
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		return err
+// Option restoring a copy of the config, maps are shared and must not be changed
+func (c loadConfig) option() LoadOption {
+	return func(target *loadConfig) {
+		*target = c
 	}
-
-	return nil
 }
 
-func loadDictionaryLikeData(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
+// WithLenient skips malformed lines instead of failing the load, each one is
+// appended to report in loading order. Loads are strict by default, failing
+// with a *ParseError on the first malformed line
+func WithLenient(report *ParseReport) LoadOption {
+	return func(c *loadConfig) {
+		c.report = report
 	}
+}
 
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
+// Load unihan database from source files into the default database
+func Load(path string, opts ...LoadOption) error {
+	return defaultDB.Load(path, opts...)
+}
 
-				if Database[codePoint].Properties.DictionaryLikeData == nil {
-					Database[codePoint].Properties.DictionaryLikeData = make(map[string][]string)
-				}
+// LoadZip loads unihan database from the official Unihan.zip archive into the default database
+func LoadZip(path string, opts ...LoadOption) error {
+	return defaultDB.LoadZip(path, opts...)
+}
 
-				Database[codePoint].Properties.DictionaryLikeData[matches[2]] = append(Database[codePoint].Properties.DictionaryLikeData[matches[2]], strings.Fields(matches[3])...)
-			}
-		}
-	}
+// LoadFS loads unihan database from a file system into the default database
+func LoadFS(fsys fs.FS, opts ...LoadOption) error {
+	return defaultDB.LoadFS(fsys, opts...)
+}
 
-	if err := scanner.Err(); err != nil {
+/* {{{ [DB struct] */
+// Load unihan database from source files
+func (db *DB) Load(path string, opts ...LoadOption) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		// File path failed
 		return err
 	}
 
-	return nil
+	return db.LoadFS(os.DirFS(filepath.Clean(abs)), opts...)
 }
 
-func loadIRGSources(path string) error {
-	f, err := os.Open(path)
+// LoadZip loads unihan database from a zip archive, such as the official Unihan.zip
+func (db *DB) LoadZip(path string, opts ...LoadOption) error {
+	r, err := zip.OpenReader(path)
 	if err != nil {
 		return err
 	}
 
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
+	defer r.Close()
 
-				if Database[codePoint].Properties.IRGSources == nil {
-					Database[codePoint].Properties.IRGSources = make(map[string][]string)
-				}
+	return db.LoadFS(r, opts...)
+}
 
-				Database[codePoint].Properties.IRGSources[matches[2]] = append(Database[codePoint].Properties.IRGSources[matches[2]], strings.Fields(matches[3])...)
+// LoadFS loads unihan database from any file system holding the source files,
+// either at its root or in a single sub directory (embed.FS, zip archive, etc.)
+//
+// Loads merge into the database, so further categories can be loaded later.
+// Loading a category again replaces its (selected) fields instead of appending.
+// Malformed lines fail the load with a *ParseError unless WithLenient() is given,
+// nothing is merged then
+func (db *DB) LoadFS(fsys fs.FS, opts ...LoadOption) error {
+	config := &loadConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	selected := categories
+	if len(config.categories) > 0 {
+		for _, category := range config.categories {
+			if !slices.Contains(categories, category) {
+				return fmt.Errorf("unknown unihan category %q", category)
 			}
 		}
-	}
 
-	return nil
-}
+		// Keep loading order
+		selected = slices.DeleteFunc(slices.Clone(categories), func(category string) bool {
+			return !slices.Contains(config.categories, category)
+		})
+	}
 
-func loadNumericValues(path string) error {
-	f, err := os.Open(path)
+	fsys, err := unihanRoot(fsys, selected)
 	if err != nil {
 		return err
 	}
 
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
+	// Parse files concurrently, readers are not blocked meanwhile
+	partials := make([]*partialCategory, len(selected))
+	errs := make([]error, len(selected))
+	var wg sync.WaitGroup
+	for i, category := range selected {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
-
-				if Database[codePoint].Properties.NumericValues == nil {
-					Database[codePoint].Properties.NumericValues = make(map[string][]string)
-				}
+			partials[i], errs[i] = parseCategory(fsys, category, config)
+		}()
+	}
 
-				Database[codePoint].Properties.NumericValues[matches[2]] = append(Database[codePoint].Properties.NumericValues[matches[2]], strings.Fields(matches[3])...)
+	wg.Wait()
+	if config.report != nil {
+		for _, partial := range partials {
+			if partial != nil {
+				config.report.Warnings = append(config.report.Warnings, partial.warnings...)
 			}
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
+	// First failure in loading order, so strict loads report a single *ParseError
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return db.merge(partials, config)
 }
 
-func loadOtherMappings(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
+// Categories returns the source files loaded so far, in loading order
+func (db *DB) Categories() []string {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
 
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
+	return slices.DeleteFunc(slices.Clone(categories), func(category string) bool {
+		return !db.loaded[category]
+	})
+}
 
-				if Database[codePoint].Properties.OtherMappings == nil {
-					Database[codePoint].Properties.OtherMappings = make(map[string][]string)
-				}
+// Merge parsed files in loading order and rebuild indexes, in one write lock.
+// Only packing may fail, the merged content is published anyway
+func (db *DB) merge(partials []*partialCategory, config *loadConfig) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
 
-				Database[codePoint].Properties.OtherMappings[matches[2]] = append(Database[codePoint].Properties.OtherMappings[matches[2]], strings.Fields(matches[3])...)
-			}
-		}
+	db.compact = db.compact || config.compact
+	db.config = *config
+	if db.loaded == nil {
+		db.loaded = make(map[string]bool)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func loadRadicalStrokeCounts(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
+	if db.headers == nil {
+		db.headers = make(map[string]FileHeader)
 	}
 
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
+	// Characters are replaced by modified copies, never changed in place,
+	// so readers holding a *Han keep a consistent view
+	touched := make(map[rune]*Han)
+	mutable := func(codePoint rune) *Han {
+		han := touched[codePoint]
+		if han == nil {
+			han = db.hans[codePoint]
+			if han == nil {
+				// Create new item
+				han = newHan(db, codePoint)
+			} else {
+				han = han.clone()
+				han.unpack()
+			}
 
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
+			touched[codePoint] = han
+			db.hans[codePoint] = han
 		}
 
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
+		return han
+	}
 
-				if Database[codePoint].Properties.RadicalStrokeCounts == nil {
-					Database[codePoint].Properties.RadicalStrokeCounts = make(map[string][]string)
+	for _, partial := range partials {
+		if db.loaded[partial.name] {
+			// Loaded before, drop values about to be read again
+			for codePoint, han := range db.hans {
+				if len(han.categoryFields(partial.name)) > 0 {
+					mutable(codePoint).clearCategoryFields(partial.name, config.fields)
 				}
-
-				Database[codePoint].Properties.RadicalStrokeCounts[matches[2]] = append(Database[codePoint].Properties.RadicalStrokeCounts[matches[2]], strings.Fields(matches[3])...)
 			}
 		}
+
+		for _, record := range partial.records {
+			mutable(record.codePoint).addCategoryField(partial.name, record.field, record.value)
+		}
+
+		db.loaded[partial.name] = true
+		db.headers[partial.name] = partial.header
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
+	var err error
+	if db.compact {
+		err = db.packAll(touched)
 	}
 
-	return nil
+	db.index.Store(buildIndex(db.hans))
+	db.generation.Add(1)
+
+	return err
 }
 
-func loadReadings(path string) error {
-	f, err := os.Open(path)
+/* }}} */
+
+// Parse one source file with the selected fields
+func parseCategory(fsys fs.FS, name string, config *loadConfig) (*partialCategory, error) {
+	f, err := fsys.Open(name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer f.Close()
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
-
-				if Database[codePoint].Properties.Readings == nil {
-					Database[codePoint].Properties.Readings = make(map[string]string)
-				}
-
-				Database[codePoint].Properties.Readings[matches[2]] = matches[3]
-			}
+	partial := &partialCategory{name: name}
+	parser := NewParser(f, name)
+	parser.SetLenient(config.report != nil)
+	for record := range parser.Records() {
+		if config.fields == nil || config.fields[record.Field] {
+			partial.records = append(partial.records, fieldRecord{
+				codePoint: record.CodePoint,
+				field:     record.Field,
+				value:     record.Value,
+			})
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
+	if err := parser.Err(); err != nil {
+		return nil, err
 	}
 
-	return nil
-}
+	partial.header = parser.Header()
+	partial.warnings = parser.Warnings()
 
-func loadVariants(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-
-	defer f.Close()
+	return partial, nil
+}
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		// Line by line
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
+// Source files may be packed under a top-level directory (Unihan/ in some archives),
+// found by any of the selected files as partial sets may be shipped
+func unihanRoot(fsys fs.FS, selected []string) (fs.FS, error) {
+	for _, category := range selected {
+		_, err := fs.Stat(fsys, category)
+		if err == nil {
+			return fsys, nil
 		}
+	}
 
-		// Comments out
-		if strings.HasPrefix(line, "#") {
-			continue
+	for _, category := range selected {
+		matches, err := fs.Glob(fsys, "*/"+category)
+		if err != nil {
+			return nil, err
 		}
 
-		reLine := regexp.MustCompile(`(U\+.+?)\s+(k.+?)\s+(.+)`)
-		matches := reLine.FindStringSubmatch(line)
-		if len(matches) == 4 {
-			codePoint := UnicodeToRune(matches[1])
-			if codePoint > 0 {
-				// Create new item
-				if Database[codePoint] == nil {
-					Database[codePoint] = &Han{
-						CodePoint: codePoint,
-						Unicode:   matches[1],
-						Value:     string(codePoint),
-					}
-				}
-
-				if Database[codePoint].Properties.Variants == nil {
-					Database[codePoint].Properties.Variants = make(map[string][]string)
-				}
-
-				Database[codePoint].Properties.Variants[matches[2]] = append(Database[codePoint].Properties.Variants[matches[2]], strings.Fields(matches[3])...)
-			}
+		if len(matches) == 1 {
+			return fs.Sub(fsys, path.Dir(matches[0]))
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
-	}
-
-	return nil
+	return fsys, nil
 }
 
 /*
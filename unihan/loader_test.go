@@ -0,0 +1,222 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file loader_test.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// Excerpt of the Unihan source files, 22 characters of every category
+const testdataDir = "testdata/Unihan"
+
+// Load the testdata excerpt into a new database
+func loadTestdata(tb testing.TB, opts ...LoadOption) *DB {
+	tb.Helper()
+
+	db := New()
+	err := db.Load(testdataDir, opts...)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return db
+}
+
+func TestLoad(t *testing.T) {
+	db := loadTestdata(t)
+	if n := db.Count(); n != 22 {
+		t.Fatalf("Count() = %d, want 22", n)
+	}
+
+	han := db.GetHanByValue("我")
+	if han == nil {
+		t.Fatal("GetHanByValue(我) = nil")
+	}
+
+	if readings := han.Mandarin(); len(readings) != 1 || readings[0] != (Pinyin{Syllable: "wo", Tone: 3}) {
+		t.Errorf("Mandarin() = %v, want [wǒ]", readings)
+	}
+
+	if version := db.Version(); version != "16.0.0" {
+		t.Errorf("Version() = %q, want 16.0.0", version)
+	}
+}
+
+func TestLoadStrict(t *testing.T) {
+	fsys := fstest.MapFS{
+		Readings: {Data: []byte("U+6211\tkMandarin\twǒ\nU+4E0\tkMandarin\tyī\nU+9EC4\tkMandarin\n")},
+	}
+
+	db := New()
+	err := db.LoadFS(fsys, WithCategories(Readings))
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("LoadFS() = %v, want *ParseError", err)
+	}
+
+	if parseErr.File != Readings || parseErr.Line != 2 || parseErr.Column != 1 {
+		t.Errorf("ParseError = %v, want %s line 2 column 1", parseErr, Readings)
+	}
+
+	if n := db.Count(); n != 0 {
+		t.Errorf("Count() = %d after a failed load, want 0", n)
+	}
+
+	var report ParseReport
+	err = db.LoadFS(fsys, WithCategories(Readings), WithLenient(&report))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Warnings) != 2 || report.Warnings[1].Line != 3 {
+		t.Errorf("Warnings = %v, want lines 2 and 3", report.Warnings)
+	}
+
+	if n := db.Count(); n != 1 {
+		t.Errorf("Count() = %d after a lenient load, want 1", n)
+	}
+}
+
+// Partial sets of source files, packed under a directory as in archives
+func TestLoadFSPartialNested(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Unihan/" + Readings: {Data: []byte("U+6211\tkMandarin\twǒ\n")},
+	}
+
+	db := New()
+	err := db.LoadFS(fsys, WithCategories(Readings))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := db.Count(); n != 1 {
+		t.Errorf("Count() = %d, want 1", n)
+	}
+}
+
+// Readers run through loads, reloads, compaction and side data loads, run
+// with -race
+func TestLoadConcurrentReaders(t *testing.T) {
+	const readers = 4
+	db := New()
+
+	var stop atomic.Bool
+	var passes atomic.Int64
+	var wg sync.WaitGroup
+	for range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for !stop.Load() {
+				if han := db.GetHanByValue("我"); han != nil {
+					han.Mandarin()
+					han.TotalStrokes(LocaleG)
+					han.Rank()
+					han.Components()
+					han.Dump()
+				}
+
+				db.GetHanByCodePoint(0x9EC4)
+				db.GetHanByUnicode("U+5988")
+				db.Count()
+				db.FindByPinyin("huang", 0)
+				db.FindByRadical(62, -1)
+				db.FindByTotalStrokes(7)
+				db.FindByFourCorner("2355?")
+				db.FindByCangjie("HQI")
+				db.Query().Pinyin("fa").OrderByStrokes().Run()
+				db.Query().StrokesBetween(1, 10).Limit(5).Run()
+				passes.Add(1)
+			}
+		}()
+	}
+
+	defer func() {
+		stop.Store(true)
+		wg.Wait()
+	}()
+
+	// Let readers go through the published content before the next change
+	settle := func() {
+		target := passes.Load() + 2*readers
+		for passes.Load() < target {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"partial load", func() error { return db.Load(testdataDir, WithCategories(Readings)) }},
+		{"full load", func() error { return db.Load(testdataDir) }},
+		{"frequency", func() error { return db.LoadFrequency(strings.NewReader("我\t100\n黄\t50\n")) }},
+		{"ids", func() error { return db.LoadIDS(strings.NewReader("U+6797\t林\t⿰木木\n")) }},
+		{"reload", func() error { return db.Reload(testdataDir) }},
+		{"compact", db.Compact},
+		{"compact reload", func() error { return db.Reload(testdataDir) }},
+	}
+
+	for range 3 {
+		for _, step := range steps {
+			err := step.run()
+			if err != nil {
+				t.Fatalf("%s: %v", step.name, err)
+			}
+
+			settle()
+		}
+	}
+
+	if n := db.Count(); n != 22 {
+		t.Errorf("Count() = %d, want 22", n)
+	}
+
+	if han := db.GetHanByValue("我"); han == nil || han.Count != 300 {
+		t.Errorf("corpus counts lost by reloads")
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,271 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file parser.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Record is one "U+XXXX<TAB>kField<TAB>value" line of a UCD / unihan source file
+type Record struct {
+	CodePoint rune
+	Unicode   string
+	Field     string
+	Value     string
+	Line      int
+}
+
+// FileHeader is read from the leading comments of a source file :
+//
+//	# Unihan_Readings.txt
+//	# Date: 2024-07-31 00:00:00 GMT [KL]
+//	# Unicode version: 16.0.0
+type FileHeader struct {
+	Name           string `json:"name"`
+	Date           string `json:"date"`
+	UnicodeVersion string `json:"unicode_version"`
+}
+
+// ParseError locates a malformed line of a source file. Column is the 1-based
+// byte offset of the offending column, 0 if the whole line is concerned
+type ParseError struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Reason string `json:"reason"`
+}
+
+// ParseReport collects the malformed lines skipped by lenient parsing, see WithLenient()
+type ParseReport struct {
+	Warnings []*ParseError `json:"warnings"`
+}
+
+// Parser streams records out of a UCD tab separated file
+type Parser struct {
+	scanner  *bufio.Scanner
+	name     string
+	line     int
+	err      error
+	header   FileHeader
+	body     bool // a record was read, later comments are not header
+	lenient  bool
+	warnings []*ParseError
+}
+
+// NewParser creates a parser reading from r, name is used in error messages
+func NewParser(r io.Reader, name string) *Parser {
+	return &Parser{
+		scanner: bufio.NewScanner(r),
+		name:    name,
+	}
+}
+
+/* {{{ [ParseError struct] */
+func (e *ParseError) Error() string {
+	position := strconv.Itoa(e.Line)
+	if e.Column > 0 {
+		position += ":" + strconv.Itoa(e.Column)
+	}
+
+	if e.File == "" {
+		return "line " + position + ": " + e.Reason
+	}
+
+	return e.File + ":" + position + ": " + e.Reason
+}
+
+/* }}} */
+
+/* {{{ [ParseReport struct] */
+// Err returns all warnings joined, nil if none
+func (r *ParseReport) Err() error {
+	errs := make([]error, len(r.Warnings))
+	for i, warning := range r.Warnings {
+		errs[i] = warning
+	}
+
+	return errors.Join(errs...)
+}
+
+/* }}} */
+
+/* {{{ [Parser struct] */
+// SetLenient makes Records() skip malformed lines instead of stopping at the
+// first one, skipped lines are reported by Warnings()
+func (p *Parser) SetLenient(lenient bool) {
+	p.lenient = lenient
+}
+
+// Records iterates over all records, stops at the first malformed line unless
+// lenient. Err() reports the reason after the iteration, a *ParseError for
+// malformed lines
+func (p *Parser) Records() iter.Seq[Record] {
+	return func(yield func(Record) bool) {
+		for p.err == nil && p.scanner.Scan() {
+			p.line++
+
+			// Line by line, columns counted from the raw line
+			text := strings.TrimRightFunc(p.scanner.Text(), unicode.IsSpace)
+			line := strings.TrimLeftFunc(text, unicode.IsSpace)
+			if line == "" {
+				continue
+			}
+
+			// Comments out
+			if strings.HasPrefix(line, "#") {
+				if !p.body {
+					p.parseHeader(line)
+				}
+
+				continue
+			}
+
+			p.body = true
+
+			record, err := p.parse(text, line)
+			if err != nil {
+				if p.lenient {
+					p.warnings = append(p.warnings, err)
+
+					continue
+				}
+
+				p.err = err
+
+				return
+			}
+
+			if !yield(record) {
+				return
+			}
+		}
+
+		if err := p.scanner.Err(); err != nil && p.err == nil {
+			// Unreadable, even if lenient
+			p.err = &ParseError{File: p.name, Line: p.line + 1, Reason: err.Error()}
+		}
+	}
+}
+
+// Header returns the header comments read so far, complete once the first
+// record was yielded
+func (p *Parser) Header() FileHeader {
+	return p.header
+}
+
+// Err returns the first read or parse error
+func (p *Parser) Err() error {
+	return p.err
+}
+
+// Warnings returns the malformed lines skipped so far by a lenient parser
+func (p *Parser) Warnings() []*ParseError {
+	return p.warnings
+}
+
+// Parse a line without trailing spaces, record is its non blank part
+func (p *Parser) parse(text, record string) (Record, *ParseError) {
+	code, rest := cutField(record)
+	field, value := cutField(rest)
+
+	// Columns are suffixes of text, so located by length
+	column := func(s string) int {
+		return len(text) - len(s) + 1
+	}
+
+	codePoint, err := ParseUnicode(code)
+	if err != nil {
+		return Record{}, p.errorAt(column(record), err.Error())
+	}
+
+	if field == "" {
+		return Record{}, p.errorAt(column(""), "missing field name, truncated line?")
+	}
+
+	if !strings.HasPrefix(field, "k") {
+		return Record{}, p.errorAt(column(rest), fmt.Sprintf("invalid field name %q", field))
+	}
+
+	if value == "" {
+		return Record{}, p.errorAt(column(""), fmt.Sprintf("missing value of %s, truncated line?", field))
+	}
+
+	return Record{
+		CodePoint: codePoint,
+		Unicode:   code,
+		Field:     field,
+		Value:     value,
+		Line:      p.line,
+	}, nil
+}
+
+func (p *Parser) parseHeader(line string) {
+	comment := strings.TrimSpace(strings.TrimPrefix(line, "#"))
+	if date, ok := strings.CutPrefix(comment, "Date:"); ok {
+		p.header.Date = strings.TrimSpace(date)
+	} else if version, ok := strings.CutPrefix(comment, "Unicode version:"); ok {
+		p.header.UnicodeVersion = strings.TrimSpace(version)
+	} else if p.header.Name == "" && strings.HasSuffix(comment, ".txt") && !strings.ContainsRune(comment, ' ') {
+		p.header.Name = comment
+	}
+}
+
+func (p *Parser) errorAt(column int, reason string) *ParseError {
+	return &ParseError{File: p.name, Line: p.line, Column: column, Reason: reason}
+}
+
+/* }}} */
+
+// Split the first tab (or white space) separated column off
+func cutField(s string) (string, string) {
+	i := strings.IndexFunc(s, unicode.IsSpace)
+	if i < 0 {
+		return s, ""
+	}
+
+	return s[:i], strings.TrimSpace(s[i:])
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
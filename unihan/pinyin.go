@@ -0,0 +1,206 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file pinyin.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Tone of a neutral (light) syllable
+const ToneNeutral = 5
+
+// Pinyin is one Hanyu Pinyin syllable
+type Pinyin struct {
+	Syllable string `json:"syllable"` // Toneless, lower case, ü and ê kept
+	Tone     int    `json:"tone"`     // 1 - 4, ToneNeutral
+}
+
+// Combining marks of tone 1 - 4
+var pinyinToneMarks = [5]rune{0, '̄', '́', '̌', '̀'}
+
+// Precomposed tone marked letters
+var pinyinMarked = map[rune][5]rune{
+	'a': {'a', 'ā', 'á', 'ǎ', 'à'},
+	'e': {'e', 'ē', 'é', 'ě', 'è'},
+	'i': {'i', 'ī', 'í', 'ǐ', 'ì'},
+	'o': {'o', 'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'u', 'ū', 'ú', 'ǔ', 'ù'},
+	'ü': {'ü', 'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+	'ê': {'ê', 0, 'ế', 0, 'ề'},
+	'm': {'m', 0, 'ḿ', 0, 0},
+	'n': {'n', 0, 'ń', 'ň', 'ǹ'},
+}
+
+// Tone marked letter => base letter and tone
+var pinyinUnmarked = func() map[rune][2]rune {
+	m := make(map[rune][2]rune)
+	for base, marked := range pinyinMarked {
+		for tone := 1; tone <= 4; tone++ {
+			if marked[tone] != 0 {
+				m[marked[tone]] = [2]rune{base, rune(tone)}
+			}
+		}
+	}
+
+	return m
+}()
+
+// ParsePinyin parses a tone marked ("lǜ") or tone numbered ("lv4", "lu:4", "lü4") syllable,
+// syllables without tone are neutral, as well as "ma5", "ma0" and "·ma"
+func ParsePinyin(s string) (Pinyin, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "·")
+	p := Pinyin{
+		Tone: ToneNeutral,
+	}
+
+	if n := len(s); n > 1 && s[n-1] >= '0' && s[n-1] <= '5' {
+		if s[n-1] > '0' {
+			p.Tone = int(s[n-1] - '0')
+		}
+
+		s = s[:n-1]
+	}
+
+	s = strings.ReplaceAll(s, "u:", "ü")
+	s = strings.ReplaceAll(s, "v", "ü")
+
+	var sb strings.Builder
+	for _, r := range s {
+		if unmarked, ok := pinyinUnmarked[r]; ok {
+			r = unmarked[0]
+			p.Tone = int(unmarked[1])
+		} else {
+			for tone := 1; tone <= 4; tone++ {
+				if r == pinyinToneMarks[tone] {
+					p.Tone = tone
+					r = 0
+				}
+			}
+
+			if r == 0 {
+				continue
+			}
+		}
+
+		if (r < 'a' || r > 'z') && r != 'ü' && r != 'ê' {
+			return Pinyin{}, fmt.Errorf("invalid pinyin %q", s)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	p.Syllable = sb.String()
+	if p.Syllable == "" || !strings.ContainsAny(p.Syllable, "aeiouüêmn") {
+		return Pinyin{}, fmt.Errorf("invalid pinyin %q", s)
+	}
+
+	return p, nil
+}
+
+// Whether a syllable states the neutral tone ("ma5", "ma0", "·ma"), ParsePinyin()
+// gives the same tone to syllables without any
+func explicitNeutral(s string) bool {
+	s = strings.TrimSpace(s)
+
+	return strings.HasPrefix(s, "·") || strings.HasSuffix(s, "5") || strings.HasSuffix(s, "0")
+}
+
+/* {{{ [Pinyin struct] */
+// String returns the tone marked form
+func (p Pinyin) String() string {
+	return p.ToneMark()
+}
+
+// ToneMark returns the syllable with tone mark, "lǜ"
+func (p Pinyin) ToneMark() string {
+	if p.Tone < 1 || p.Tone > 4 {
+		return p.Syllable
+	}
+
+	pos := pinyinMarkPosition(p.Syllable)
+	if pos < 0 {
+		return p.Syllable
+	}
+
+	base, size := utf8.DecodeRuneInString(p.Syllable[pos:])
+	marked := string(base) + string(pinyinToneMarks[p.Tone])
+	if precomposed := pinyinMarked[base][p.Tone]; precomposed != 0 {
+		marked = string(precomposed)
+	}
+
+	return p.Syllable[:pos] + marked + p.Syllable[pos+size:]
+}
+
+// ToneNumber returns the syllable followed by tone number, "lü4"
+func (p Pinyin) ToneNumber() string {
+	if p.Tone < 1 || p.Tone > ToneNeutral {
+		return p.Syllable
+	}
+
+	return fmt.Sprintf("%s%d", p.Syllable, p.Tone)
+}
+
+// Toneless returns the syllable without tone, "lü"
+func (p Pinyin) Toneless() string {
+	return p.Syllable
+}
+
+/* }}} */
+
+// Byte offset of the letter carrying the tone mark :
+// a / e first, o of ou, then the last vowel, syllabic m / n at last
+func pinyinMarkPosition(syllable string) int {
+	if i := strings.IndexAny(syllable, "aeê"); i >= 0 {
+		return i
+	}
+
+	if i := strings.Index(syllable, "ou"); i >= 0 {
+		return i
+	}
+
+	if i := strings.LastIndexAny(syllable, "iouü"); i >= 0 {
+		return i
+	}
+
+	return strings.IndexAny(syllable, "mn")
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,378 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file query.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+)
+
+// Dictionary-like fields used by queries
+const (
+	FieldFrequency  = "kFrequency"
+	FieldGradeLevel = "kGradeLevel"
+)
+
+// HanQuery composes predicates over a database, see Query()
+type HanQuery struct {
+	db         *DB
+	blocks     []Block
+	syllable   string
+	tone       int
+	radical    int
+	residual   int
+	strokesMin int
+	strokesMax int
+	filters    []func(*Han) bool
+	order      func(a, b *Han) int
+	offset     int
+	limit      int
+}
+
+// Query starts a query over the default database
+func Query() *HanQuery {
+	return defaultDB.Query()
+}
+
+/* {{{ [DB struct] */
+// Query starts a query over the database
+func (db *DB) Query() *HanQuery {
+	return &HanQuery{
+		db:       db,
+		residual: -1,
+	}
+}
+
+/* }}} */
+
+/* {{{ [HanQuery struct] */
+// Block keeps characters of any of the blocks
+func (q *HanQuery) Block(blocks ...Block) *HanQuery {
+	q.blocks = append(q.blocks, blocks...)
+
+	return q
+}
+
+// Pinyin keeps characters with a Mandarin reading of the syllable, tone marks or numbers also set Tone
+func (q *HanQuery) Pinyin(syllable string) *HanQuery {
+	p, err := ParsePinyin(syllable)
+	if err != nil {
+		// Nothing matches
+		q.syllable = syllable
+	} else {
+		q.syllable = p.Syllable
+		if p.Tone != ToneNeutral || explicitNeutral(syllable) {
+			q.tone = p.Tone
+		}
+	}
+
+	return q
+}
+
+// Tone keeps characters with a Mandarin reading of the tone (1 - 4, ToneNeutral),
+// combined with Pinyin the same reading must match both
+func (q *HanQuery) Tone(tone int) *HanQuery {
+	q.tone = tone
+
+	return q
+}
+
+// Radical keeps characters of the Kangxi radical, negative residual for any
+func (q *HanQuery) Radical(radical, residual int) *HanQuery {
+	q.radical = radical
+	q.residual = residual
+
+	return q
+}
+
+// Strokes keeps characters of the total strokes (G or T)
+func (q *HanQuery) Strokes(strokes int) *HanQuery {
+	return q.StrokesBetween(strokes, strokes)
+}
+
+// StrokesBetween keeps characters with total strokes (G or T) in range, inclusive
+func (q *HanQuery) StrokesBetween(from, to int) *HanQuery {
+	q.strokesMin = from
+	q.strokesMax = to
+
+	return q
+}
+
+// GradeLevel keeps characters with kGradeLevel in range, inclusive
+func (q *HanQuery) GradeLevel(from, to int) *HanQuery {
+	return q.Where(func(h *Han) bool {
+		level := h.GradeLevel()
+
+		return level > 0 && level >= from && level <= to
+	})
+}
+
+// Frequency keeps characters with kFrequency in range, inclusive
+func (q *HanQuery) Frequency(from, to int) *HanQuery {
+	return q.Where(func(h *Han) bool {
+		frequency := h.Frequency()
+
+		return frequency > 0 && frequency >= from && frequency <= to
+	})
+}
+
+// Has keeps characters having the field, in any category
+func (q *HanQuery) Has(field string) *HanQuery {
+	return q.Where(func(h *Han) bool {
+		return h.fieldValues(field) != nil
+	})
+}
+
+// Field keeps characters whose values of the field satisfy match
+func (q *HanQuery) Field(field string, match func(values []string) bool) *HanQuery {
+	return q.Where(func(h *Han) bool {
+		values := h.fieldValues(field)
+
+		return values != nil && match(values)
+	})
+}
+
+// FieldEquals keeps characters having value among the values of the field
+func (q *HanQuery) FieldEquals(field, value string) *HanQuery {
+	return q.Field(field, func(values []string) bool {
+		return slices.Contains(values, value)
+	})
+}
+
+// FieldInt keeps characters with the first value of a numeric field in range, inclusive
+func (q *HanQuery) FieldInt(field string, from, to int) *HanQuery {
+	return q.Field(field, func(values []string) bool {
+		n, err := strconv.Atoi(values[0])
+
+		return err == nil && n >= from && n <= to
+	})
+}
+
+// Where keeps characters satisfying an arbitrary predicate
+func (q *HanQuery) Where(filter func(*Han) bool) *HanQuery {
+	q.filters = append(q.filters, filter)
+
+	return q
+}
+
+// OrderBy sorts results, by code point if not set
+func (q *HanQuery) OrderBy(order func(a, b *Han) int) *HanQuery {
+	q.order = order
+
+	return q
+}
+
+// OrderByStrokes sorts results by total strokes (G), then by code point
+func (q *HanQuery) OrderByStrokes() *HanQuery {
+	return q.OrderBy(func(a, b *Han) int {
+		return cmp.Or(
+			cmp.Compare(a.TotalStrokes(LocaleG), b.TotalStrokes(LocaleG)),
+			cmp.Compare(a.CodePoint, b.CodePoint),
+		)
+	})
+}
+
+// OrderByRank sorts results from the most common, unranked characters last
+func (q *HanQuery) OrderByRank() *HanQuery {
+	return q.OrderBy(compareCommonness)
+}
+
+// Offset skips the first n results, 0 or less for none
+func (q *HanQuery) Offset(n int) *HanQuery {
+	q.offset = max(n, 0)
+
+	return q
+}
+
+// Limit returns at most n results, 0 for no limit
+func (q *HanQuery) Limit(n int) *HanQuery {
+	q.limit = n
+
+	return q
+}
+
+// Run executes the query
+func (q *HanQuery) Run() []*Han {
+	results := q.match()
+	if q.order != nil {
+		slices.SortStableFunc(results, q.order)
+	}
+
+	if q.offset >= len(results) {
+		return nil
+	}
+
+	results = results[q.offset:]
+	if q.limit > 0 && q.limit < len(results) {
+		results = results[:q.limit]
+	}
+
+	return results
+}
+
+// CodePoints executes the query and returns code points of results
+func (q *HanQuery) CodePoints() []rune {
+	results := q.Run()
+	codePoints := make([]rune, len(results))
+	for i, han := range results {
+		codePoints[i] = han.CodePoint
+	}
+
+	return codePoints
+}
+
+// Count returns the number of matches, ignoring pagination
+func (q *HanQuery) Count() int {
+	return len(q.match())
+}
+
+// Matches ordered by code point
+func (q *HanQuery) match() []*Han {
+	hans := q.db.view()
+	var results []*Han
+	candidates, indexed := q.candidates()
+	if indexed {
+		for _, codePoint := range candidates {
+			han := hans[codePoint]
+			if han != nil && q.test(han) {
+				results = append(results, han)
+			}
+		}
+
+		return results
+	}
+
+	for _, han := range hans {
+		if q.test(han) {
+			results = append(results, han)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b *Han) int {
+		return cmp.Compare(a.CodePoint, b.CodePoint)
+	})
+
+	return results
+}
+
+// Narrow down by the smallest index list, false if no index applies
+func (q *HanQuery) candidates() ([]rune, bool) {
+	idx := q.db.index.Load()
+	if idx == nil {
+		return nil, false
+	}
+
+	var lists [][]rune
+	if q.syllable != "" {
+		var codePoints []rune
+		for tone := 1; tone <= ToneNeutral; tone++ {
+			if q.tone == 0 || q.tone == tone {
+				codePoints = append(codePoints, idx.pinyin[Pinyin{Syllable: q.syllable, Tone: tone}]...)
+			}
+		}
+
+		slices.Sort(codePoints)
+		lists = append(lists, slices.Compact(codePoints))
+	}
+
+	if q.radical > 0 {
+		if q.residual < 0 {
+			lists = append(lists, idx.radicals[q.radical])
+		} else {
+			lists = append(lists, idx.radicalStroke[[2]int{q.radical, q.residual}])
+		}
+	}
+
+	if q.strokesMin > 0 && q.strokesMin == q.strokesMax {
+		lists = append(lists, idx.strokes[q.strokesMin])
+	}
+
+	if len(lists) == 0 {
+		return nil, false
+	}
+
+	return slices.MinFunc(lists, func(a, b []rune) int {
+		return cmp.Compare(len(a), len(b))
+	}), true
+}
+
+func (q *HanQuery) test(h *Han) bool {
+	if len(q.blocks) > 0 && !slices.ContainsFunc(q.blocks, func(b Block) bool {
+		return b.Contains(h.CodePoint)
+	}) {
+		return false
+	}
+
+	if q.syllable != "" || q.tone > 0 {
+		if !slices.ContainsFunc(h.PinyinReadings(), func(p Pinyin) bool {
+			return (q.syllable == "" || p.Syllable == q.syllable) && (q.tone == 0 || p.Tone == q.tone)
+		}) {
+			return false
+		}
+	}
+
+	if q.radical > 0 && !slices.ContainsFunc(h.RadicalStroke(), func(rs RadicalStroke) bool {
+		return rs.Radical == q.radical && (q.residual < 0 || rs.Residual == q.residual)
+	}) {
+		return false
+	}
+
+	if q.strokesMin > 0 || q.strokesMax > 0 {
+		g, t := h.TotalStrokes(LocaleG), h.TotalStrokes(LocaleT)
+		inRange := func(n int) bool {
+			return n > 0 && n >= q.strokesMin && (q.strokesMax <= 0 || n <= q.strokesMax)
+		}
+
+		if !inRange(g) && !inRange(t) {
+			return false
+		}
+	}
+
+	for _, filter := range q.filters {
+		if !filter(h) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/* }}} */
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
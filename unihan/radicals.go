@@ -0,0 +1,461 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file radicals.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Radical / stroke fields
+const (
+	FieldRSUnicode    = "kRSUnicode"
+	FieldTotalStrokes = "kTotalStrokes"
+)
+
+// Locale selects the G (mainland China) or T (Taiwan) value of locale dependent fields
+type Locale int
+
+const (
+	LocaleG Locale = iota
+	LocaleT
+)
+
+// Simplified radical forms marked by apostrophes in kRSUnicode
+const (
+	RadicalTraditional         = 0 // 85.4
+	RadicalSimplified          = 1 // 120'.3, Chinese simplified form
+	RadicalSimplifiedNonChina  = 2 // 120''.3, non-Chinese simplified form
+	RadicalSimplifiedNonChina2 = 3 // 120'''.3, second non-Chinese simplified form
+)
+
+// RadicalStroke is one kRSUnicode value, "85.4" / "120'.3"
+type RadicalStroke struct {
+	Radical    int `json:"radical"`    // Kangxi radical number, 1 - 214
+	Simplified int `json:"simplified"` // Count of apostrophes, RadicalTraditional ...
+	Residual   int `json:"residual"`   // Strokes besides the radical, may be negative
+}
+
+// KangxiRadical is one of the 214 radicals of the Kangxi Dictionary
+type KangxiRadical struct {
+	Number     int    `json:"number"`
+	Glyph      rune   `json:"glyph"`      // CJK unified ideograph form
+	Simplified rune   `json:"simplified"` // Chinese simplified form, 0 if none
+	Strokes    int    `json:"strokes"`
+	Pinyin     string `json:"pinyin"`
+}
+
+var KangxiRadicals = [214]KangxiRadical{
+	{1, '一', 0, 1, "yī"},
+	{2, '丨', 0, 1, "gǔn"},
+	{3, '丶', 0, 1, "zhǔ"},
+	{4, '丿', 0, 1, "piě"},
+	{5, '乙', 0, 1, "yǐ"},
+	{6, '亅', 0, 1, "jué"},
+	{7, '二', 0, 2, "èr"},
+	{8, '亠', 0, 2, "tóu"},
+	{9, '人', 0, 2, "rén"},
+	{10, '儿', 0, 2, "ér"},
+	{11, '入', 0, 2, "rù"},
+	{12, '八', 0, 2, "bā"},
+	{13, '冂', 0, 2, "jiōng"},
+	{14, '冖', 0, 2, "mì"},
+	{15, '冫', 0, 2, "bīng"},
+	{16, '几', 0, 2, "jī"},
+	{17, '凵', 0, 2, "kǎn"},
+	{18, '刀', 0, 2, "dāo"},
+	{19, '力', 0, 2, "lì"},
+	{20, '勹', 0, 2, "bāo"},
+	{21, '匕', 0, 2, "bǐ"},
+	{22, '匚', 0, 2, "fāng"},
+	{23, '匸', 0, 2, "xì"},
+	{24, '十', 0, 2, "shí"},
+	{25, '卜', 0, 2, "bǔ"},
+	{26, '卩', 0, 2, "jié"},
+	{27, '厂', 0, 2, "hàn"},
+	{28, '厶', 0, 2, "sī"},
+	{29, '又', 0, 2, "yòu"},
+	{30, '口', 0, 3, "kǒu"},
+	{31, '囗', 0, 3, "wéi"},
+	{32, '土', 0, 3, "tǔ"},
+	{33, '士', 0, 3, "shì"},
+	{34, '夂', 0, 3, "zhǐ"},
+	{35, '夊', 0, 3, "suī"},
+	{36, '夕', 0, 3, "xī"},
+	{37, '大', 0, 3, "dà"},
+	{38, '女', 0, 3, "nǚ"},
+	{39, '子', 0, 3, "zǐ"},
+	{40, '宀', 0, 3, "mián"},
+	{41, '寸', 0, 3, "cùn"},
+	{42, '小', 0, 3, "xiǎo"},
+	{43, '尢', 0, 3, "wāng"},
+	{44, '尸', 0, 3, "shī"},
+	{45, '屮', 0, 3, "chè"},
+	{46, '山', 0, 3, "shān"},
+	{47, '巛', 0, 3, "chuān"},
+	{48, '工', 0, 3, "gōng"},
+	{49, '己', 0, 3, "jǐ"},
+	{50, '巾', 0, 3, "jīn"},
+	{51, '干', 0, 3, "gān"},
+	{52, '幺', 0, 3, "yāo"},
+	{53, '广', 0, 3, "guǎng"},
+	{54, '廴', 0, 3, "yǐn"},
+	{55, '廾', 0, 3, "gǒng"},
+	{56, '弋', 0, 3, "yì"},
+	{57, '弓', 0, 3, "gōng"},
+	{58, '彐', 0, 3, "jì"},
+	{59, '彡', 0, 3, "shān"},
+	{60, '彳', 0, 3, "chì"},
+	{61, '心', 0, 4, "xīn"},
+	{62, '戈', 0, 4, "gē"},
+	{63, '戶', 0, 4, "hù"},
+	{64, '手', 0, 4, "shǒu"},
+	{65, '支', 0, 4, "zhī"},
+	{66, '攴', 0, 4, "pū"},
+	{67, '文', 0, 4, "wén"},
+	{68, '斗', 0, 4, "dǒu"},
+	{69, '斤', 0, 4, "jīn"},
+	{70, '方', 0, 4, "fāng"},
+	{71, '无', 0, 4, "wú"},
+	{72, '日', 0, 4, "rì"},
+	{73, '曰', 0, 4, "yuē"},
+	{74, '月', 0, 4, "yuè"},
+	{75, '木', 0, 4, "mù"},
+	{76, '欠', 0, 4, "qiàn"},
+	{77, '止', 0, 4, "zhǐ"},
+	{78, '歹', 0, 4, "dǎi"},
+	{79, '殳', 0, 4, "shū"},
+	{80, '毋', 0, 4, "wú"},
+	{81, '比', 0, 4, "bǐ"},
+	{82, '毛', 0, 4, "máo"},
+	{83, '氏', 0, 4, "shì"},
+	{84, '气', 0, 4, "qì"},
+	{85, '水', 0, 4, "shuǐ"},
+	{86, '火', 0, 4, "huǒ"},
+	{87, '爪', 0, 4, "zhǎo"},
+	{88, '父', 0, 4, "fù"},
+	{89, '爻', 0, 4, "yáo"},
+	{90, '爿', 0, 4, "qiáng"},
+	{91, '片', 0, 4, "piàn"},
+	{92, '牙', 0, 4, "yá"},
+	{93, '牛', 0, 4, "niú"},
+	{94, '犬', 0, 4, "quǎn"},
+	{95, '玄', 0, 5, "xuán"},
+	{96, '玉', 0, 5, "yù"},
+	{97, '瓜', 0, 5, "guā"},
+	{98, '瓦', 0, 5, "wǎ"},
+	{99, '甘', 0, 5, "gān"},
+	{100, '生', 0, 5, "shēng"},
+	{101, '用', 0, 5, "yòng"},
+	{102, '田', 0, 5, "tián"},
+	{103, '疋', 0, 5, "pǐ"},
+	{104, '疒', 0, 5, "nè"},
+	{105, '癶', 0, 5, "bō"},
+	{106, '白', 0, 5, "bái"},
+	{107, '皮', 0, 5, "pí"},
+	{108, '皿', 0, 5, "mǐn"},
+	{109, '目', 0, 5, "mù"},
+	{110, '矛', 0, 5, "máo"},
+	{111, '矢', 0, 5, "shǐ"},
+	{112, '石', 0, 5, "shí"},
+	{113, '示', 0, 5, "shì"},
+	{114, '禸', 0, 5, "róu"},
+	{115, '禾', 0, 5, "hé"},
+	{116, '穴', 0, 5, "xué"},
+	{117, '立', 0, 5, "lì"},
+	{118, '竹', 0, 6, "zhú"},
+	{119, '米', 0, 6, "mǐ"},
+	{120, '糸', '纟', 6, "mì"},
+	{121, '缶', 0, 6, "fǒu"},
+	{122, '网', 0, 6, "wǎng"},
+	{123, '羊', 0, 6, "yáng"},
+	{124, '羽', 0, 6, "yǔ"},
+	{125, '老', 0, 6, "lǎo"},
+	{126, '而', 0, 6, "ér"},
+	{127, '耒', 0, 6, "lěi"},
+	{128, '耳', 0, 6, "ěr"},
+	{129, '聿', 0, 6, "yù"},
+	{130, '肉', 0, 6, "ròu"},
+	{131, '臣', 0, 6, "chén"},
+	{132, '自', 0, 6, "zì"},
+	{133, '至', 0, 6, "zhì"},
+	{134, '臼', 0, 6, "jiù"},
+	{135, '舌', 0, 6, "shé"},
+	{136, '舛', 0, 6, "chuǎn"},
+	{137, '舟', 0, 6, "zhōu"},
+	{138, '艮', 0, 6, "gèn"},
+	{139, '色', 0, 6, "sè"},
+	{140, '艸', 0, 6, "cǎo"},
+	{141, '虍', 0, 6, "hū"},
+	{142, '虫', 0, 6, "chóng"},
+	{143, '血', 0, 6, "xuè"},
+	{144, '行', 0, 6, "xíng"},
+	{145, '衣', 0, 6, "yī"},
+	{146, '襾', 0, 6, "yà"},
+	{147, '見', '见', 7, "jiàn"},
+	{148, '角', 0, 7, "jiǎo"},
+	{149, '言', '讠', 7, "yán"},
+	{150, '谷', 0, 7, "gǔ"},
+	{151, '豆', 0, 7, "dòu"},
+	{152, '豕', 0, 7, "shǐ"},
+	{153, '豸', 0, 7, "zhì"},
+	{154, '貝', '贝', 7, "bèi"},
+	{155, '赤', 0, 7, "chì"},
+	{156, '走', 0, 7, "zǒu"},
+	{157, '足', 0, 7, "zú"},
+	{158, '身', 0, 7, "shēn"},
+	{159, '車', '车', 7, "chē"},
+	{160, '辛', 0, 7, "xīn"},
+	{161, '辰', 0, 7, "chén"},
+	{162, '辵', 0, 7, "chuò"},
+	{163, '邑', 0, 7, "yì"},
+	{164, '酉', 0, 7, "yǒu"},
+	{165, '釆', 0, 7, "biàn"},
+	{166, '里', 0, 7, "lǐ"},
+	{167, '金', '钅', 8, "jīn"},
+	{168, '長', '长', 8, "cháng"},
+	{169, '門', '门', 8, "mén"},
+	{170, '阜', 0, 8, "fù"},
+	{171, '隶', 0, 8, "lì"},
+	{172, '隹', 0, 8, "zhuī"},
+	{173, '雨', 0, 8, "yǔ"},
+	{174, '靑', 0, 8, "qīng"},
+	{175, '非', 0, 8, "fēi"},
+	{176, '面', 0, 9, "miàn"},
+	{177, '革', 0, 9, "gé"},
+	{178, '韋', '韦', 9, "wéi"},
+	{179, '韭', 0, 9, "jiǔ"},
+	{180, '音', 0, 9, "yīn"},
+	{181, '頁', '页', 9, "yè"},
+	{182, '風', '风', 9, "fēng"},
+	{183, '飛', 0, 9, "fēi"},
+	{184, '食', '饣', 9, "shí"},
+	{185, '首', 0, 9, "shǒu"},
+	{186, '香', 0, 9, "xiāng"},
+	{187, '馬', '马', 10, "mǎ"},
+	{188, '骨', 0, 10, "gǔ"},
+	{189, '高', 0, 10, "gāo"},
+	{190, '髟', 0, 10, "biāo"},
+	{191, '鬥', 0, 10, "dòu"},
+	{192, '鬯', 0, 10, "chàng"},
+	{193, '鬲', 0, 10, "lì"},
+	{194, '鬼', 0, 10, "guǐ"},
+	{195, '魚', '鱼', 11, "yú"},
+	{196, '鳥', '鸟', 11, "niǎo"},
+	{197, '鹵', '卤', 11, "lǔ"},
+	{198, '鹿', 0, 11, "lù"},
+	{199, '麥', '麦', 11, "mài"},
+	{200, '麻', 0, 11, "má"},
+	{201, '黃', 0, 12, "huáng"},
+	{202, '黍', 0, 12, "shǔ"},
+	{203, '黑', 0, 12, "hēi"},
+	{204, '黹', 0, 12, "zhǐ"},
+	{205, '黽', '黾', 13, "mǐn"},
+	{206, '鼎', 0, 13, "dǐng"},
+	{207, '鼓', 0, 13, "gǔ"},
+	{208, '鼠', 0, 13, "shǔ"},
+	{209, '鼻', 0, 14, "bí"},
+	{210, '齊', '齐', 14, "qí"},
+	{211, '齒', '齿', 15, "chǐ"},
+	{212, '龍', '龙', 16, "lóng"},
+	{213, '龜', '龟', 16, "guī"},
+	{214, '龠', 0, 17, "yuè"},
+}
+
+// RadicalForms maps positional variant forms of radicals to their Kangxi radical numbers
+var RadicalForms = map[rune]int{
+	'亻': 9,
+	'冫': 15,
+	'刂': 18,
+	'⺌': 42,
+	'尣': 43,
+	'彑': 58,
+	'忄': 61,
+	'㣺': 61,
+	'扌': 64,
+	'攵': 66,
+	'歺': 78,
+	'氵': 85,
+	'氺': 85,
+	'灬': 86,
+	'爫': 87,
+	'丬': 90,
+	'牜': 93,
+	'犭': 94,
+	'王': 96,
+	'礻': 113,
+	'⺮': 118,
+	'糹': 120,
+	'罒': 122,
+	'罓': 122,
+	'耂': 125,
+	'艹': 140,
+	'衤': 145,
+	'覀': 146,
+	'訁': 149,
+	'𧾷': 157,
+	'辶': 162,
+	'阝': 170,
+	'釒': 167,
+	'镸': 168,
+	'飠': 184,
+}
+
+// GetKangxiRadical returns radical by number (1 - 214)
+func GetKangxiRadical(number int) *KangxiRadical {
+	if number < 1 || number > len(KangxiRadicals) {
+		return nil
+	}
+
+	return &KangxiRadicals[number-1]
+}
+
+// ParseRadicalStroke parses a kRSUnicode value, "85.4" or "120'.3"
+func ParseRadicalStroke(s string) (RadicalStroke, error) {
+	radical, residual, ok := strings.Cut(s, ".")
+	if !ok {
+		return RadicalStroke{}, fmt.Errorf("invalid radical stroke %q", s)
+	}
+
+	rs := RadicalStroke{}
+	for strings.HasSuffix(radical, "'") {
+		radical = radical[:len(radical)-1]
+		rs.Simplified++
+	}
+
+	var err error
+	rs.Radical, err = strconv.Atoi(radical)
+	if err != nil || rs.Radical < 1 || rs.Radical > len(KangxiRadicals) || rs.Simplified > RadicalSimplifiedNonChina2 {
+		return RadicalStroke{}, fmt.Errorf("invalid radical stroke %q", s)
+	}
+
+	rs.Residual, err = strconv.Atoi(residual)
+	if err != nil {
+		return RadicalStroke{}, fmt.Errorf("invalid radical stroke %q", s)
+	}
+
+	return rs, nil
+}
+
+/* {{{ [Locale] */
+func (l Locale) String() string {
+	if l == LocaleT {
+		return "T"
+	}
+
+	return "G"
+}
+
+/* }}} */
+
+// ComponentRadical returns the radical a component stands for, by its unified
+// ideograph, simplified, Kangxi Radicals block or variant form, nil if none
+func ComponentRadical(r rune) *KangxiRadical {
+	if r >= 0x2F00 && r <= 0x2FD5 {
+		return GetKangxiRadical(int(r-0x2F00) + 1)
+	}
+
+	if number, ok := RadicalForms[r]; ok {
+		return GetKangxiRadical(number)
+	}
+
+	for i := range KangxiRadicals {
+		if KangxiRadicals[i].Glyph == r || KangxiRadicals[i].Simplified == r {
+			return &KangxiRadicals[i]
+		}
+	}
+
+	return nil
+}
+
+/* {{{ [RadicalStroke struct] */
+func (rs RadicalStroke) String() string {
+	return fmt.Sprintf("%d%s.%d", rs.Radical, strings.Repeat("'", rs.Simplified), rs.Residual)
+}
+
+// KangxiRadical returns the radical of the table
+func (rs RadicalStroke) KangxiRadical() *KangxiRadical {
+	return GetKangxiRadical(rs.Radical)
+}
+
+/* }}} */
+
+/* {{{ [KangxiRadical struct] */
+// Symbol returns the character of the Kangxi Radicals block (U+2F00 - U+2FD5)
+func (r *KangxiRadical) Symbol() rune {
+	return 0x2F00 + rune(r.Number-1)
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// RadicalStroke returns kRSUnicode values, the first one is the primary radical
+func (h *Han) RadicalStroke() []RadicalStroke {
+	var values []RadicalStroke
+	for _, s := range h.fieldValues(FieldRSUnicode) {
+		rs, err := ParseRadicalStroke(s)
+		if err == nil {
+			values = append(values, rs)
+		}
+	}
+
+	return values
+}
+
+// TotalStrokes returns kTotalStrokes of the locale, 0 if unknown.
+// A single value applies to both G and T
+func (h *Han) TotalStrokes(locale Locale) int {
+	values := h.fieldValues(FieldTotalStrokes)
+	if len(values) == 0 {
+		return 0
+	}
+
+	value := values[0]
+	if locale == LocaleT && len(values) > 1 {
+		value = values[1]
+	}
+
+	n, _ := strconv.Atoi(value)
+
+	return n
+}
+
+/* }}} */
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
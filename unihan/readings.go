@@ -0,0 +1,280 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file readings.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Reading fields of Unihan_Readings.txt
+const (
+	FieldCantonese   = "kCantonese"
+	FieldDefinition  = "kDefinition"
+	FieldHangul      = "kHangul"
+	FieldHanyuPinlu  = "kHanyuPinlu"
+	FieldHanyuPinyin = "kHanyuPinyin"
+	FieldJapanese    = "kJapanese"
+	FieldJapaneseKun = "kJapaneseKun"
+	FieldJapaneseOn  = "kJapaneseOn"
+	FieldKorean      = "kKorean"
+	FieldMandarin    = "kMandarin"
+	FieldTGHZ2013    = "kTGHZ2013"
+	FieldVietnamese  = "kVietnamese"
+	FieldXHC1983     = "kXHC1983"
+)
+
+// HanyuLocation is a position in the Hanyu Da Zidian, "10019.020" :
+// volume 1, page 19, character 2, virtual flag 0
+type HanyuLocation struct {
+	Volume   int `json:"volume"`
+	Page     int `json:"page"`
+	Position int `json:"position"`
+	Virtual  int `json:"virtual"`
+}
+
+// HanyuPinyinEntry is one kHanyuPinyin value, "10019.020,10020.020:tiàn,tiān"
+type HanyuPinyinEntry struct {
+	Locations []HanyuLocation `json:"locations"`
+	Readings  []Pinyin        `json:"readings"`
+}
+
+// LocatedPinyin is one kXHC1983 / kTGHZ2013 value, locations are kept as is
+type LocatedPinyin struct {
+	Locations []string `json:"locations"`
+	Readings  []Pinyin `json:"readings"`
+}
+
+// PinluReading is one kHanyuPinlu value, "hǎo(6060)"
+type PinluReading struct {
+	Pinyin    Pinyin `json:"pinyin"`
+	Frequency int    `json:"frequency"`
+}
+
+// HangulReading is one kHangul value, "한:0E"
+type HangulReading struct {
+	Hangul  string `json:"hangul"`
+	Sources string `json:"sources"`
+}
+
+// ParseHanyuLocation parses a Hanyu Da Zidian position, "10019.020"
+func ParseHanyuLocation(s string) (HanyuLocation, error) {
+	page, pos, ok := strings.Cut(s, ".")
+	if !ok || len(page) != 5 || len(pos) != 3 || !isDigits(page) || !isDigits(pos) {
+		return HanyuLocation{}, fmt.Errorf("invalid hanyu location %q", s)
+	}
+
+	return HanyuLocation{
+		Volume:   int(page[0] - '0'),
+		Page:     atoi(page[1:]),
+		Position: atoi(pos[:2]),
+		Virtual:  int(pos[2] - '0'),
+	}, nil
+}
+
+/* {{{ [HanyuLocation struct] */
+func (l HanyuLocation) String() string {
+	return fmt.Sprintf("%d%04d.%02d%d", l.Volume, l.Page, l.Position, l.Virtual)
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// Mandarin returns kMandarin readings, the most customary one first
+func (h *Han) Mandarin() []Pinyin {
+	return parsePinyinList(strings.Fields(h.reading(FieldMandarin)))
+}
+
+// Cantonese returns kCantonese readings
+func (h *Han) Cantonese() []Jyutping {
+	var readings []Jyutping
+	for _, s := range strings.Fields(h.reading(FieldCantonese)) {
+		j, err := ParseJyutping(s)
+		if err == nil {
+			readings = append(readings, j)
+		}
+	}
+
+	return readings
+}
+
+// HanyuPinyin returns kHanyuPinyin entries with their Hanyu Da Zidian locations
+func (h *Han) HanyuPinyin() []HanyuPinyinEntry {
+	var entries []HanyuPinyinEntry
+	for _, value := range strings.Fields(h.reading(FieldHanyuPinyin)) {
+		locations, readings, ok := strings.Cut(value, ":")
+		if !ok {
+			continue
+		}
+
+		entry := HanyuPinyinEntry{
+			Readings: parsePinyinList(strings.Split(readings, ",")),
+		}
+
+		for _, s := range strings.Split(locations, ",") {
+			location, err := ParseHanyuLocation(s)
+			if err == nil {
+				entry.Locations = append(entry.Locations, location)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// HanyuPinlu returns kHanyuPinlu readings with their frequencies
+func (h *Han) HanyuPinlu() []PinluReading {
+	var readings []PinluReading
+	for _, value := range strings.Fields(h.reading(FieldHanyuPinlu)) {
+		syllable, freq, ok := strings.Cut(strings.TrimSuffix(value, ")"), "(")
+		if !ok {
+			continue
+		}
+
+		p, err := ParsePinyin(syllable)
+		if err != nil {
+			continue
+		}
+
+		n, _ := strconv.Atoi(freq)
+		readings = append(readings, PinluReading{
+			Pinyin:    p,
+			Frequency: n,
+		})
+	}
+
+	return readings
+}
+
+// XHC1983 returns kXHC1983 (Xiandai Hanyu Cidian) readings
+func (h *Han) XHC1983() []LocatedPinyin {
+	return parseLocatedPinyin(h.reading(FieldXHC1983))
+}
+
+// TGHZ2013 returns kTGHZ2013 (Tongyong Guifan Hanzi Zidian) readings
+func (h *Han) TGHZ2013() []LocatedPinyin {
+	return parseLocatedPinyin(h.reading(FieldTGHZ2013))
+}
+
+// JapaneseOn returns kJapaneseOn (Sino-Japanese) readings in upper case romaji
+func (h *Han) JapaneseOn() []string {
+	return strings.Fields(h.reading(FieldJapaneseOn))
+}
+
+// JapaneseKun returns kJapaneseKun (native Japanese) readings in romaji
+func (h *Han) JapaneseKun() []string {
+	return strings.Fields(h.reading(FieldJapaneseKun))
+}
+
+// Japanese returns kJapanese readings in kana
+func (h *Han) Japanese() []string {
+	return strings.Fields(h.reading(FieldJapanese))
+}
+
+// Korean returns kKorean readings in Yale romanization
+func (h *Han) Korean() []string {
+	return strings.Fields(h.reading(FieldKorean))
+}
+
+// Hangul returns kHangul readings with their source flags
+func (h *Han) Hangul() []HangulReading {
+	var readings []HangulReading
+	for _, value := range strings.Fields(h.reading(FieldHangul)) {
+		hangul, sources, _ := strings.Cut(value, ":")
+		readings = append(readings, HangulReading{
+			Hangul:  hangul,
+			Sources: sources,
+		})
+	}
+
+	return readings
+}
+
+// Vietnamese returns kVietnamese readings in Quốc ngữ
+func (h *Han) Vietnamese() []string {
+	return strings.Fields(h.reading(FieldVietnamese))
+}
+
+// Definition returns kDefinition, major senses are separated by semicolons
+func (h *Han) Definition() []string {
+	var senses []string
+	for _, sense := range strings.Split(h.reading(FieldDefinition), ";") {
+		sense = strings.TrimSpace(sense)
+		if sense != "" {
+			senses = append(senses, sense)
+		}
+	}
+
+	return senses
+}
+
+/* }}} */
+
+func parsePinyinList(values []string) []Pinyin {
+	var readings []Pinyin
+	for _, s := range values {
+		p, err := ParsePinyin(s)
+		if err == nil {
+			readings = append(readings, p)
+		}
+	}
+
+	return readings
+}
+
+func parseLocatedPinyin(field string) []LocatedPinyin {
+	var entries []LocatedPinyin
+	for _, value := range strings.Fields(field) {
+		locations, readings, ok := strings.Cut(value, ":")
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, LocatedPinyin{
+			Locations: strings.Split(locations, ","),
+			Readings:  parsePinyinList(strings.Split(readings, ",")),
+		})
+	}
+
+	return entries
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,225 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file reload.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Reload replaces the default database with a fresh load, see DB.Reload()
+func Reload(path string, opts ...LoadOption) error {
+	return defaultDB.Reload(path, opts...)
+}
+
+// Watch reloads the default database on changes, see DB.Watch()
+func Watch(ctx context.Context, path string, interval time.Duration, onReload func(error)) {
+	defaultDB.Watch(ctx, path, interval, onReload)
+}
+
+// Generation returns the content generation of the default database
+func Generation() uint64 {
+	return defaultDB.Generation()
+}
+
+/* {{{ [DB struct] */
+// Reload loads a source directory or zip archive (by .zip suffix) into a fresh
+// database off to the side, then swaps its content in at once, readers see
+// either the old or the new content. Without options the categories loaded so
+// far are reloaded with the field selection and lenient report of the latest
+// load, compact storage is kept in any case. Corpus counts and IDS data
+// (see LoadFrequency() and LoadIDS()) are carried over to the characters still
+// present. The database is unchanged if the load fails
+func (db *DB) Reload(path string, opts ...LoadOption) error {
+	db.lock.RLock()
+	compact := db.compact
+	config := db.config
+	config.categories = nil
+	for _, category := range categories {
+		if db.loaded[category] {
+			config.categories = append(config.categories, category)
+		}
+	}
+	db.lock.RUnlock()
+
+	if len(opts) == 0 {
+		opts = append(opts, config.option())
+	}
+
+	if compact {
+		opts = append(opts, WithCompact())
+	}
+
+	fresh := New()
+	var err error
+	if isZipPath(path) {
+		err = fresh.LoadZip(path, opts...)
+	} else {
+		err = fresh.Load(path, opts...)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	db.swap(fresh)
+
+	return nil
+}
+
+// Watch polls a source directory or zip archive every interval and reloads the
+// database when files changed and stayed unchanged for one more interval (so
+// files still being copied are not read). onReload, if not nil, receives the
+// result of every reload, a failed reload is retried once files change again.
+// Watch blocks until ctx is done
+func (db *DB) Watch(ctx context.Context, path string, interval time.Duration, onReload func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	current := sourceSignature(path)
+	pending := current
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		signature := sourceSignature(path)
+		if signature == current {
+			pending = current
+
+			continue
+		}
+
+		if signature != pending {
+			// Changed since the last poll, wait for it to settle
+			pending = signature
+
+			continue
+		}
+
+		// Failed or not, these files were tried
+		err := db.Reload(path)
+		current = signature
+
+		if onReload != nil {
+			onReload(err)
+		}
+	}
+}
+
+// Generation returns a counter increased by every change of content (loads,
+// reloads, snapshots, frequency and IDS data), to tell whether cached results
+// are stale
+func (db *DB) Generation() uint64 {
+	return db.generation.Load()
+}
+
+// Replace content by another database, which must not be used afterwards.
+// Side data not read from source files is kept
+func (db *DB) swap(fresh *DB) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	carried := false
+	for codePoint, han := range fresh.hans {
+		// Not visible to readers yet
+		han.db = db
+		if old := db.hans[codePoint]; old != nil && (old.Count != 0 || len(old.IDS) > 0) {
+			han.Count = old.Count
+			han.IDS = old.IDS
+			carried = true
+		}
+	}
+
+	clear(db.hans)
+	for codePoint, han := range fresh.hans {
+		db.hans[codePoint] = han
+	}
+
+	db.loaded = fresh.loaded
+	db.headers = fresh.headers
+	db.compact = fresh.compact
+	db.config = fresh.config
+	if carried {
+		// Ranks and components depend on the side data
+		db.index.Store(buildIndex(db.hans))
+	} else {
+		db.index.Store(fresh.index.Load())
+	}
+
+	db.generation.Add(1)
+}
+
+/* }}} */
+
+func isZipPath(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".zip")
+}
+
+// Sizes and modification times of the source files, the archive itself for zip
+func sourceSignature(path string) string {
+	files := []string{path}
+	if !isZipPath(path) {
+		files = files[:0]
+		for _, category := range categories {
+			files = append(files, filepath.Join(path, category))
+
+			// Packed under a single sub directory, see unihanRoot()
+			nested, _ := filepath.Glob(filepath.Join(path, "*", category))
+			files = append(files, nested...)
+		}
+	}
+
+	var b strings.Builder
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err == nil {
+			fmt.Fprintf(&b, "%s:%d:%d;", filepath.Base(file), info.Size(), info.ModTime().UnixNano())
+		}
+	}
+
+	return b.String()
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,129 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file reload_test.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Copy the testdata excerpt to a temporary directory
+func copyTestdata(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, category := range categories {
+		data, err := os.ReadFile(filepath.Join(testdataDir, category))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(filepath.Join(dir, category), data, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestReloadKeepsOptions(t *testing.T) {
+	db := loadTestdata(t, WithCategories(Readings), WithFields(FieldMandarin))
+	err := db.Reload(testdataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := db.GetHanByValue("我").categoryFields(Readings)
+	if len(fields) != 1 || fields[FieldMandarin] == nil {
+		t.Errorf("Readings fields after Reload() = %v, want kMandarin only", fields)
+	}
+
+	if loaded := db.Categories(); len(loaded) != 1 || loaded[0] != Readings {
+		t.Errorf("Categories() after Reload() = %v, want [%s]", loaded, Readings)
+	}
+}
+
+// A failed reload is not retried until files change again
+func TestWatchFailedReload(t *testing.T) {
+	dir := copyTestdata(t)
+	db := New()
+	err := db.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var attempts, failures atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		db.Watch(ctx, dir, 5*time.Millisecond, func(err error) {
+			attempts.Add(1)
+			if err != nil {
+				failures.Add(1)
+			}
+		})
+	}()
+
+	// Corrupt a file once the watch took its first signature
+	time.Sleep(50 * time.Millisecond)
+	err = os.WriteFile(filepath.Join(dir, Readings), []byte("U+6211\tkMandarin\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	<-done
+
+	if n, f := attempts.Load(), failures.Load(); n != 1 || f != 1 {
+		t.Errorf("%d reloads (%d failed), want 1 failed", n, f)
+	}
+
+	if n := db.Count(); n != 22 {
+		t.Errorf("Count() = %d after a failed reload, want 22", n)
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,600 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file romanization.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Valid Mandarin syllables (toneless), in the order used to resolve reverse lookups
+const pinyinSyllables = `a ai an ang ao e ei en eng er o ou ê
+ba bai ban bang bao bei ben beng bi bian biao bie bin bing bo bu
+pa pai pan pang pao pei pen peng pi pian piao pie pin ping po pou pu
+ma mai man mang mao me mei men meng mi mian miao mie min ming miu mo mou mu
+fa fan fang fei fen feng fiao fo fou fu
+da dai dan dang dao de dei den deng di dia dian diao die ding diu dong dou du duan dui dun duo
+ta tai tan tang tao te tei teng ti tian tiao tie ting tong tou tu tuan tui tun tuo
+na nai nan nang nao ne nei nen neng ni nian niang niao nie nin ning niu nong nou nu nuan nun nuo nü nüe
+la lai lan lang lao le lei leng li lia lian liang liao lie lin ling liu long lou lu luan lun luo lo lü lüe
+ga gai gan gang gao ge gei gen geng gong gou gu gua guai guan guang gui gun guo
+ka kai kan kang kao ke kei ken keng kong kou ku kua kuai kuan kuang kui kun kuo
+ha hai han hang hao he hei hen heng hong hou hu hua huai huan huang hui hun huo
+ji jia jian jiang jiao jie jin jing jiong jiu ju juan jue jun
+qi qia qian qiang qiao qie qin qing qiong qiu qu quan que qun
+xi xia xian xiang xiao xie xin xing xiong xiu xu xuan xue xun
+zha zhai zhan zhang zhao zhe zhei zhen zheng zhi zhong zhou zhu zhua zhuai zhuan zhuang zhui zhun zhuo
+cha chai chan chang chao che chen cheng chi chong chou chu chua chuai chuan chuang chui chun chuo
+sha shai shan shang shao she shei shen sheng shi shou shu shua shuai shuan shuang shui shun shuo
+ran rang rao re ren reng ri rong rou ru rua ruan rui run ruo
+za zai zan zang zao ze zei zen zeng zi zong zou zu zuan zui zun zuo
+ca cai can cang cao ce cen ceng ci cong cou cu cuan cui cun cuo
+sa sai san sang sao se sen seng si song sou su suan sui sun suo
+ya yan yang yao ye yi yin ying yo yong you yu yuan yue yun
+wa wai wan wang wei wen weng wo wu
+m n ng hm hng`
+
+var pinyinInitials = []string{"zh", "ch", "sh", "b", "p", "m", "f", "d", "t", "n", "l", "g", "k", "h", "j", "q", "x", "r", "z", "c", "s"}
+
+// Spellings without initial => canonical finals
+var pinyinZeroInitials = map[string]string{
+	"yi": "i", "ya": "ia", "yo": "io", "ye": "ie", "yai": "iai", "yao": "iao", "you": "iou",
+	"yan": "ian", "yin": "in", "yang": "iang", "ying": "ing", "yong": "iong",
+	"yu": "ü", "yue": "üe", "yuan": "üan", "yun": "ün",
+	"wu": "u", "wa": "ua", "wo": "uo", "wai": "uai", "wei": "uei", "wan": "uan", "wen": "uen",
+	"wang": "uang", "weng": "ueng",
+}
+
+var zhuyinInitials = map[string]string{
+	"b": "ㄅ", "p": "ㄆ", "m": "ㄇ", "f": "ㄈ", "d": "ㄉ", "t": "ㄊ", "n": "ㄋ", "l": "ㄌ",
+	"g": "ㄍ", "k": "ㄎ", "h": "ㄏ", "j": "ㄐ", "q": "ㄑ", "x": "ㄒ",
+	"zh": "ㄓ", "ch": "ㄔ", "sh": "ㄕ", "r": "ㄖ", "z": "ㄗ", "c": "ㄘ", "s": "ㄙ",
+}
+
+var zhuyinFinals = map[string]string{
+	"a": "ㄚ", "o": "ㄛ", "e": "ㄜ", "ê": "ㄝ", "ai": "ㄞ", "ei": "ㄟ", "ao": "ㄠ", "ou": "ㄡ",
+	"an": "ㄢ", "en": "ㄣ", "ang": "ㄤ", "eng": "ㄥ", "ong": "ㄨㄥ", "er": "ㄦ", "-i": "",
+	"i": "ㄧ", "ia": "ㄧㄚ", "io": "ㄧㄛ", "ie": "ㄧㄝ", "iai": "ㄧㄞ", "iao": "ㄧㄠ", "iou": "ㄧㄡ",
+	"ian": "ㄧㄢ", "in": "ㄧㄣ", "iang": "ㄧㄤ", "ing": "ㄧㄥ", "iong": "ㄩㄥ",
+	"u": "ㄨ", "ua": "ㄨㄚ", "uo": "ㄨㄛ", "uai": "ㄨㄞ", "uei": "ㄨㄟ", "uan": "ㄨㄢ", "uen": "ㄨㄣ",
+	"uang": "ㄨㄤ", "ueng": "ㄨㄥ",
+	"ü": "ㄩ", "üe": "ㄩㄝ", "üan": "ㄩㄢ", "ün": "ㄩㄣ",
+	"m": "ㄇ", "n": "ㄋ", "ng": "ㄫ", "hm": "ㄏㄇ", "hng": "ㄏㄫ",
+}
+
+var zhuyinTones = [6]string{"", "", "ˊ", "ˇ", "ˋ", "˙"}
+
+var wadeGilesInitials = map[string]string{
+	"b": "p", "p": "p'", "m": "m", "f": "f", "d": "t", "t": "t'", "n": "n", "l": "l",
+	"g": "k", "k": "k'", "h": "h", "j": "ch", "q": "ch'", "x": "hs",
+	"zh": "ch", "ch": "ch'", "sh": "sh", "r": "j", "z": "ts", "c": "ts'", "s": "s",
+}
+
+var wadeGilesFinals = map[string]string{
+	"a": "a", "o": "o", "e": "ê", "ê": "eh", "ai": "ai", "ei": "ei", "ao": "ao", "ou": "ou",
+	"an": "an", "en": "ên", "ang": "ang", "eng": "êng", "ong": "ung", "er": "êrh",
+	"i": "i", "ia": "ia", "io": "io", "ie": "ieh", "iai": "iai", "iao": "iao", "iou": "iu",
+	"ian": "ien", "in": "in", "iang": "iang", "ing": "ing", "iong": "iung",
+	"u": "u", "ua": "ua", "uo": "uo", "uai": "uai", "uei": "ui", "uan": "uan", "uen": "un",
+	"uang": "uang", "ueng": "ung",
+	"ü": "ü", "üe": "üeh", "üan": "üan", "ün": "ün",
+	"m": "m", "n": "n", "ng": "ng", "hm": "hm", "hng": "hng",
+}
+
+// Finals without initial in Wade–Giles
+var wadeGilesZeroInitials = map[string]string{
+	"e": "o", "i": "i", "ia": "ya", "io": "yo", "ie": "yeh", "iai": "yai", "iao": "yao", "iou": "yu",
+	"ian": "yen", "in": "yin", "iang": "yang", "ing": "ying", "iong": "yung",
+	"u": "wu", "ua": "wa", "uo": "wo", "uai": "wai", "uei": "wei", "uan": "wan", "uen": "wên",
+	"uang": "wang", "ueng": "wêng",
+	"ü": "yü", "üe": "yüeh", "üan": "yüan", "ün": "yün",
+}
+
+var wadeGilesTones = [6]string{"", "¹", "²", "³", "⁴", ""}
+
+var gwoyeuInitials = map[string]string{
+	"b": "b", "p": "p", "m": "m", "f": "f", "d": "d", "t": "t", "n": "n", "l": "l",
+	"g": "g", "k": "k", "h": "h", "j": "j", "q": "ch", "x": "sh",
+	"zh": "j", "ch": "ch", "sh": "sh", "r": "r", "z": "tz", "c": "ts", "s": "s",
+}
+
+// Basic (first tone) finals of Gwoyeu Romatzyh
+var gwoyeuFinals = map[string]string{
+	"a": "a", "o": "o", "e": "e", "ê": "e", "ai": "ai", "ei": "ei", "ao": "au", "ou": "ou",
+	"an": "an", "en": "en", "ang": "ang", "eng": "eng", "ong": "ong", "er": "el", "-i": "y",
+	"i": "i", "ia": "ia", "io": "io", "ie": "ie", "iai": "iai", "iao": "iau", "iou": "iou",
+	"ian": "ian", "in": "in", "iang": "iang", "ing": "ing", "iong": "iong",
+	"u": "u", "ua": "ua", "uo": "uo", "uai": "uai", "uei": "uei", "uan": "uan", "uen": "uen",
+	"uang": "uang", "ueng": "ueng",
+	"ü": "iu", "üe": "iue", "üan": "iuan", "ün": "iun",
+}
+
+// Third tone finals of Gwoyeu Romatzyh, by basic final
+var gwoyeuThirdTone = map[string]string{
+	"a": "aa", "o": "oo", "e": "ee", "ai": "ae", "ei": "eei", "au": "ao", "ou": "oou",
+	"an": "aan", "en": "een", "ang": "aang", "eng": "eeng", "ong": "oong", "el": "eel", "y": "yy",
+	"i": "ii", "ia": "ea", "io": "eo", "ie": "iee", "iai": "eai", "iau": "eau", "iou": "eou",
+	"ian": "ean", "in": "iin", "iang": "eang", "ing": "iing", "iong": "eong",
+	"u": "uu", "ua": "oa", "uo": "uoo", "uai": "oai", "uei": "oei", "uan": "oan", "uen": "oen",
+	"uang": "oang", "ueng": "oeng",
+	"iu": "eu", "iue": "eue", "iuan": "euan", "iun": "eun",
+}
+
+// Reverse lookup tables, built from pinyinSyllables at init
+var (
+	zhuyinReverse    map[string]Pinyin
+	wadeGilesReverse map[string]Pinyin
+	gwoyeuReverse    map[string]Pinyin
+)
+
+func init() {
+	zhuyinReverse = make(map[string]Pinyin)
+	wadeGilesReverse = make(map[string]Pinyin)
+	gwoyeuReverse = make(map[string]Pinyin)
+	for _, syllable := range strings.Fields(pinyinSyllables) {
+		for tone := 1; tone <= ToneNeutral; tone++ {
+			p := Pinyin{Syllable: syllable, Tone: tone}
+			for table, convert := range map[*map[string]Pinyin]func(Pinyin) (string, error){
+				&zhuyinReverse:    Pinyin.toZhuyin,
+				&wadeGilesReverse: Pinyin.toWadeGiles,
+				&gwoyeuReverse:    Pinyin.toGwoyeu,
+			} {
+				s, err := convert(p)
+				if _, ok := (*table)[s]; err == nil && !ok {
+					(*table)[s] = p
+				}
+			}
+		}
+	}
+}
+
+// PinyinToZhuyin converts a pinyin syllable (tone marked or numbered) to Zhuyin Fuhao (Bopomofo)
+func PinyinToZhuyin(s string) (string, error) {
+	p, err := ParsePinyin(s)
+	if err != nil {
+		return "", err
+	}
+
+	return p.toZhuyin()
+}
+
+// PinyinToWadeGiles converts a pinyin syllable to Wade–Giles with superscript tone numbers
+func PinyinToWadeGiles(s string) (string, error) {
+	p, err := ParsePinyin(s)
+	if err != nil {
+		return "", err
+	}
+
+	return p.toWadeGiles()
+}
+
+// PinyinToGwoyeu converts a pinyin syllable to Gwoyeu Romatzyh tonal spelling
+func PinyinToGwoyeu(s string) (string, error) {
+	p, err := ParsePinyin(s)
+	if err != nil {
+		return "", err
+	}
+
+	return p.toGwoyeu()
+}
+
+// ZhuyinToPinyin parses a Zhuyin syllable, a trailing ㄦ is taken as erhua
+func ZhuyinToPinyin(s string) (Pinyin, error) {
+	s = strings.TrimSpace(s)
+	tone := 1
+	if rest, ok := strings.CutPrefix(s, "˙"); ok {
+		s = rest
+		tone = ToneNeutral
+	}
+
+	for t := 2; t <= 4; t++ {
+		if rest, ok := strings.CutSuffix(s, zhuyinTones[t]); ok {
+			s = rest
+			tone = t
+		}
+	}
+
+	erhua := false
+	if rest, ok := strings.CutSuffix(s, "ㄦ"); ok && rest != "" {
+		s = rest
+		erhua = true
+	}
+
+	p, ok := zhuyinReverse[s]
+	if !ok {
+		return Pinyin{}, fmt.Errorf("invalid zhuyin %q", s)
+	}
+
+	p.Tone = tone
+	if erhua {
+		p.Syllable += "r"
+	}
+
+	return p, nil
+}
+
+// WadeGilesToPinyin parses a Wade–Giles syllable, tone by superscript or plain digit
+func WadeGilesToPinyin(s string) (Pinyin, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.NewReplacer("’", "'", "‘", "'", "ʻ", "'").Replace(s)
+	erhua := false
+	if rest, ok := strings.CutSuffix(s, "-êrh"); ok {
+		s = rest
+		erhua = true
+	}
+
+	tone := ToneNeutral
+	for t := 1; t <= 4; t++ {
+		for _, mark := range []string{wadeGilesTones[t], string(rune('0' + t))} {
+			if rest, ok := strings.CutSuffix(s, mark); ok {
+				s = rest
+				tone = t
+			}
+		}
+	}
+
+	p, ok := wadeGilesReverse[s]
+	if !ok {
+		return Pinyin{}, fmt.Errorf("invalid wade-giles %q", s)
+	}
+
+	p.Tone = tone
+	if erhua {
+		p.Syllable += "r"
+	}
+
+	return p, nil
+}
+
+// GwoyeuToPinyin parses a Gwoyeu Romatzyh tonal spelling, neutral tone is prefixed by a dot.
+// Erhua spellings are not reversible and are rejected
+func GwoyeuToPinyin(s string) (Pinyin, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	p, ok := gwoyeuReverse[s]
+	if !ok {
+		return Pinyin{}, fmt.Errorf("invalid gwoyeu romatzyh %q", s)
+	}
+
+	return p, nil
+}
+
+/* {{{ [Pinyin struct] */
+// Zhuyin returns the syllable in Zhuyin Fuhao, empty if not a valid syllable
+func (p Pinyin) Zhuyin() string {
+	s, _ := p.toZhuyin()
+
+	return s
+}
+
+// WadeGiles returns the syllable in Wade–Giles, empty if not a valid syllable
+func (p Pinyin) WadeGiles() string {
+	s, _ := p.toWadeGiles()
+
+	return s
+}
+
+// Gwoyeu returns the syllable in Gwoyeu Romatzyh, empty if not a valid syllable
+func (p Pinyin) Gwoyeu() string {
+	s, _ := p.toGwoyeu()
+
+	return s
+}
+
+func (p Pinyin) toZhuyin() (string, error) {
+	if p.Tone < 1 || p.Tone > ToneNeutral {
+		return "", fmt.Errorf("invalid tone %d of pinyin %q", p.Tone, p.Syllable)
+	}
+
+	initial, final, erhua, err := splitPinyin(p.Syllable)
+	if err != nil {
+		return "", err
+	}
+
+	s := zhuyinInitials[initial] + zhuyinFinals[final]
+	if erhua {
+		s += "ㄦ"
+	}
+
+	if p.Tone == ToneNeutral {
+		return zhuyinTones[ToneNeutral] + s, nil
+	}
+
+	return s + zhuyinTones[p.Tone], nil
+}
+
+func (p Pinyin) toWadeGiles() (string, error) {
+	if p.Tone < 1 || p.Tone > ToneNeutral {
+		return "", fmt.Errorf("invalid tone %d of pinyin %q", p.Tone, p.Syllable)
+	}
+
+	initial, final, erhua, err := splitPinyin(p.Syllable)
+	if err != nil {
+		return "", err
+	}
+
+	var s string
+	switch {
+	case initial == "":
+		s = wadeGilesFinals[final]
+		if zero, ok := wadeGilesZeroInitials[final]; ok {
+			s = zero
+		}
+	case final == "-i" && (initial == "z" || initial == "c" || initial == "s"):
+		s = map[string]string{"z": "tzŭ", "c": "tz'ŭ", "s": "ssŭ"}[initial]
+	case final == "-i":
+		s = wadeGilesInitials[initial] + "ih"
+	case final == "e" && (initial == "g" || initial == "k" || initial == "h"):
+		s = wadeGilesInitials[initial] + "o"
+	case final == "uei" && (initial == "g" || initial == "k"):
+		s = wadeGilesInitials[initial] + "uei"
+	case final == "uo" && initial != "g" && initial != "k" && initial != "h" && initial != "sh":
+		s = wadeGilesInitials[initial] + "o"
+	default:
+		s = wadeGilesInitials[initial] + wadeGilesFinals[final]
+	}
+
+	s += wadeGilesTones[p.Tone]
+	if erhua {
+		s += "-êrh"
+	}
+
+	return s, nil
+}
+
+func (p Pinyin) toGwoyeu() (string, error) {
+	if p.Tone < 1 || p.Tone > ToneNeutral {
+		return "", fmt.Errorf("invalid tone %d of pinyin %q", p.Tone, p.Syllable)
+	}
+
+	initial, final, erhua, err := splitPinyin(p.Syllable)
+	if err != nil {
+		return "", err
+	}
+
+	base, ok := gwoyeuFinals[final]
+	if !ok {
+		// Syllabic nasals have no tonal spelling, fall back to the tone number
+		return p.ToneNumber(), nil
+	}
+
+	sonorant := initial == "m" || initial == "n" || initial == "l" || initial == "r"
+	var s string
+	switch p.Tone {
+	case 1:
+		s = base
+		if sonorant {
+			s = "h" + s
+		}
+	case 2:
+		s = base
+		if !sonorant {
+			s = gwoyeuSecondTone(base)
+		}
+	case 3:
+		s = gwoyeuThirdTone[base]
+		if initial == "" {
+			s = gwoyeuZeroInitial(base, s)
+		}
+	case 4:
+		s = gwoyeuFourthTone(base)
+		if initial == "" {
+			s = gwoyeuZeroInitial(base, s)
+		}
+	default:
+		s = base
+	}
+
+	if erhua {
+		// Endings i / n are dropped before the retroflex l
+		s = strings.TrimRight(strings.TrimSuffix(s, "nn"), "ni") + "l"
+	}
+
+	s = gwoyeuInitials[initial] + s
+	if p.Tone == ToneNeutral {
+		s = "." + s
+	}
+
+	return s, nil
+}
+
+/* }}} */
+
+/* {{{ [Han struct] */
+// Zhuyin returns kMandarin readings in Zhuyin Fuhao
+func (h *Han) Zhuyin() []string {
+	var readings []string
+	for _, p := range h.Mandarin() {
+		if s := p.Zhuyin(); s != "" {
+			readings = append(readings, s)
+		}
+	}
+
+	return readings
+}
+
+// WadeGiles returns kMandarin readings in Wade–Giles
+func (h *Han) WadeGiles() []string {
+	var readings []string
+	for _, p := range h.Mandarin() {
+		if s := p.WadeGiles(); s != "" {
+			readings = append(readings, s)
+		}
+	}
+
+	return readings
+}
+
+// Gwoyeu returns kMandarin readings in Gwoyeu Romatzyh
+func (h *Han) Gwoyeu() []string {
+	var readings []string
+	for _, p := range h.Mandarin() {
+		if s := p.Gwoyeu(); s != "" {
+			readings = append(readings, s)
+		}
+	}
+
+	return readings
+}
+
+/* }}} */
+
+// Split a toneless syllable into initial and canonical final (iou, uei, uen, ü...),
+// a trailing r of erhua is reported separately
+func splitPinyin(syllable string) (string, string, bool, error) {
+	erhua := false
+	if syllable != "er" && strings.HasSuffix(syllable, "r") {
+		syllable = syllable[:len(syllable)-1]
+		erhua = true
+	}
+
+	invalid := fmt.Errorf("invalid pinyin syllable %q", syllable)
+	switch syllable {
+	case "m", "n", "ng", "hm", "hng":
+		return "", syllable, erhua, nil
+	}
+
+	if final, ok := pinyinZeroInitials[syllable]; ok {
+		return "", final, erhua, nil
+	}
+
+	if strings.HasPrefix(syllable, "y") || strings.HasPrefix(syllable, "w") {
+		return "", "", false, invalid
+	}
+
+	initial := ""
+	for _, i := range pinyinInitials {
+		if strings.HasPrefix(syllable, i) && len(syllable) > len(i) {
+			initial = i
+			break
+		}
+	}
+
+	final := syllable[len(initial):]
+	switch {
+	case strings.Contains("j q x", initial) && initial != "" && strings.HasPrefix(final, "u"):
+		final = "ü" + final[1:]
+	case strings.Contains("zh ch sh r z c s", initial) && initial != "" && final == "i":
+		final = "-i"
+	}
+
+	switch final {
+	case "iu":
+		final = "iou"
+	case "ui":
+		final = "uei"
+	case "un":
+		final = "uen"
+	}
+
+	if _, ok := zhuyinFinals[final]; !ok {
+		return "", "", false, invalid
+	}
+
+	return initial, final, erhua, nil
+}
+
+// Second tone: medial i / u become y / w, other finals take r after the vowels
+func gwoyeuSecondTone(base string) string {
+	switch {
+	case base == "i":
+		return "yi"
+	case base == "u":
+		return "wu"
+	case strings.HasPrefix(base, "iu"):
+		return "yu" + base[2:]
+	case strings.HasPrefix(base, "i"):
+		return "y" + base[1:]
+	case strings.HasPrefix(base, "u"):
+		return "w" + base[1:]
+	}
+
+	end := 0
+	for end < len(base) && strings.IndexByte("aeiouy", base[end]) >= 0 {
+		end++
+	}
+
+	return base[:end] + "r" + base[end:]
+}
+
+// Fourth tone: endings i, u, n, ng, l become y, w, nn, nq, ll, open finals take h
+func gwoyeuFourthTone(base string) string {
+	switch {
+	case base == "i" || base == "u" || base == "iu":
+		return base + "h"
+	case strings.HasSuffix(base, "ng"):
+		return base[:len(base)-1] + "q"
+	case strings.HasSuffix(base, "i"):
+		return base[:len(base)-1] + "y"
+	case strings.HasSuffix(base, "u"):
+		return base[:len(base)-1] + "w"
+	case strings.HasSuffix(base, "n"):
+		return base + "n"
+	case strings.HasSuffix(base, "l"):
+		return base + "l"
+	}
+
+	return base + "h"
+}
+
+// Third / fourth tone spelling without initial
+func gwoyeuZeroInitial(base, s string) string {
+	switch {
+	case base == "i" || base == "in" || base == "ing":
+		return "y" + s
+	case base == "u":
+		return "w" + s
+	case strings.HasPrefix(s, "iu"):
+		return "yu" + s[2:]
+	case strings.HasPrefix(base, "i"):
+		if strings.HasPrefix(s, "e") {
+			return "y" + s
+		}
+
+		return "y" + s[1:]
+	case strings.HasPrefix(base, "u"):
+		if strings.HasPrefix(s, "o") {
+			return "w" + s
+		}
+
+		return "w" + s[1:]
+	}
+
+	return s
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,411 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file snapshot.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"slices"
+	"sort"
+)
+
+// Snapshot format
+//
+//	magic    "UNIHAN\x00\x1a"
+//	version  uvarint
+//	strings  uvarint count, then (uvarint length, bytes) for every interned string
+//	hans     uvarint count, then for every han (ordered by code point) :
+//	         uvarint code point delta
+//	         for every category (in snapshotCategories order) :
+//	             uvarint field count, then (uvarint name, uvarint value count, uvarint values...)
+//	         uvarint corpus count, uvarint IDS count, then uvarint IDS... (since version 4)
+//	headers  uvarint count, then (uvarint category, uvarint date, uvarint unicode version)
+//	         for every loaded source file, strings are interned (since version 2)
+//	loaded   uvarint count, then uvarint category for every loaded source file, even
+//	         without data, in loading order (since version 3)
+//	crc32    IEEE checksum of everything above, big endian
+const (
+	snapshotMagic   = "UNIHAN\x00\x1a"
+	SnapshotVersion = 4
+)
+
+var (
+	ErrSnapshotFormat   = errors.New("unihan: invalid snapshot format")
+	ErrSnapshotVersion  = errors.New("unihan: unsupported snapshot version")
+	ErrSnapshotChecksum = errors.New("unihan: snapshot checksum mismatch")
+)
+
+// Categories in the order they are serialized
+var snapshotCategories = []string{
+	IRGSources,
+	OtherMappings,
+	DictionaryIndices,
+	Readings,
+	DictionaryLikeData,
+	RadicalStrokeCounts,
+	Variants,
+	NumericValues,
+}
+
+// WriteSnapshot serializes the default database into w
+func WriteSnapshot(w io.Writer) error {
+	return defaultDB.WriteSnapshot(w)
+}
+
+// ReadSnapshot replaces the default database with the snapshot read from r.
+//
+// Services needing the Han table without a data directory use package
+// embedded, or embed a snapshot written once by WriteSnapshot() (gzip
+// compressed or not) in their own module :
+//
+//	//go:embed unihan.snap.gz
+//	var snapshot []byte
+//
+//	r, err := gzip.NewReader(bytes.NewReader(snapshot))
+//	...
+//	err = unihan.ReadSnapshot(r)
+func ReadSnapshot(r io.Reader) error {
+	return defaultDB.ReadSnapshot(r)
+}
+
+/* {{{ [DB struct] */
+// WriteSnapshot serializes the database into w
+func (db *DB) WriteSnapshot(w io.Writer) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	codePoints := make([]rune, 0, len(db.hans))
+	for codePoint := range db.hans {
+		codePoints = append(codePoints, codePoint)
+	}
+
+	slices.Sort(codePoints)
+
+	// Intern field names and values
+	table := make(map[string]uint64)
+	var strs []string
+	intern := func(s string) uint64 {
+		idx, ok := table[s]
+		if !ok {
+			idx = uint64(len(strs))
+			table[s] = idx
+			strs = append(strs, s)
+		}
+
+		return idx
+	}
+
+	var body []byte
+	body = binary.AppendUvarint(body, uint64(len(codePoints)))
+	last := rune(0)
+	for _, codePoint := range codePoints {
+		han := db.hans[codePoint]
+		body = binary.AppendUvarint(body, uint64(codePoint-last))
+		last = codePoint
+		for _, category := range snapshotCategories {
+			fields := han.categoryFields(category)
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+			body = binary.AppendUvarint(body, uint64(len(names)))
+			for _, name := range names {
+				body = binary.AppendUvarint(body, intern(name))
+				body = binary.AppendUvarint(body, uint64(len(fields[name])))
+				for _, value := range fields[name] {
+					body = binary.AppendUvarint(body, intern(value))
+				}
+			}
+		}
+
+		body = binary.AppendUvarint(body, uint64(han.Count))
+		body = binary.AppendUvarint(body, uint64(len(han.IDS)))
+		for _, ids := range han.IDS {
+			body = binary.AppendUvarint(body, intern(ids))
+		}
+	}
+
+	headers := make([]string, 0, len(db.headers))
+	for category := range db.headers {
+		headers = append(headers, category)
+	}
+
+	sort.Strings(headers)
+	body = binary.AppendUvarint(body, uint64(len(headers)))
+	for _, category := range headers {
+		header := db.headers[category]
+		body = binary.AppendUvarint(body, intern(category))
+		body = binary.AppendUvarint(body, intern(header.Date))
+		body = binary.AppendUvarint(body, intern(header.UnicodeVersion))
+	}
+
+	loaded := slices.DeleteFunc(slices.Clone(categories), func(category string) bool {
+		return !db.loaded[category]
+	})
+
+	body = binary.AppendUvarint(body, uint64(len(loaded)))
+	for _, category := range loaded {
+		body = binary.AppendUvarint(body, intern(category))
+	}
+
+	h := crc32.NewIEEE()
+	bw := bufio.NewWriter(io.MultiWriter(w, h))
+
+	var head []byte
+	head = append(head, snapshotMagic...)
+	head = binary.AppendUvarint(head, SnapshotVersion)
+	head = binary.AppendUvarint(head, uint64(len(strs)))
+	for _, s := range strs {
+		head = binary.AppendUvarint(head, uint64(len(s)))
+		head = append(head, s...)
+	}
+
+	_, err := bw.Write(head)
+	if err != nil {
+		return err
+	}
+
+	_, err = bw.Write(body)
+	if err != nil {
+		return err
+	}
+
+	err = bw.Flush()
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, h.Sum32())
+}
+
+// ReadSnapshot replaces the content of the database with the snapshot read from r
+func (db *DB) ReadSnapshot(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	if len(data) < len(snapshotMagic)+4 {
+		return ErrSnapshotFormat
+	}
+
+	data, trailer := data[:len(data)-4], data[len(data)-4:]
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(trailer) {
+		return ErrSnapshotChecksum
+	}
+
+	br := &snapshotReader{
+		r: bytes.NewReader(data),
+	}
+
+	magic := make([]byte, len(snapshotMagic))
+	_, err = io.ReadFull(br.r, magic)
+	if err != nil || string(magic) != snapshotMagic {
+		return ErrSnapshotFormat
+	}
+
+	// Version 1 has no headers, version 2 no loaded categories, version 3 no
+	// corpus counts and IDS data
+	version := br.uvarint()
+	if br.err == nil && (version < 1 || version > SnapshotVersion) {
+		return fmt.Errorf("%w: %d", ErrSnapshotVersion, version)
+	}
+
+	strs := make([]string, br.count())
+	for i := range strs {
+		b := make([]byte, br.count())
+		if br.err == nil {
+			_, br.err = io.ReadFull(br.r, b)
+		}
+
+		strs[i] = string(b)
+	}
+
+	str := func() string {
+		idx := br.uvarint()
+		if idx >= uint64(len(strs)) {
+			br.fail()
+
+			return ""
+		}
+
+		return strs[idx]
+	}
+
+	hans := make(map[rune]*Han)
+	loaded := make(map[string]bool)
+	codePoint := rune(0)
+	for n := br.count(); n > 0 && br.err == nil; n-- {
+		codePoint += rune(br.uvarint())
+		han := newHan(db, codePoint)
+		for _, category := range snapshotCategories {
+			for m := br.count(); m > 0 && br.err == nil; m-- {
+				loaded[category] = true
+				name := str()
+				values := make([]string, br.count())
+				for i := range values {
+					values[i] = str()
+				}
+
+				han.setCategoryField(category, name, values)
+			}
+		}
+
+		if version >= 4 {
+			han.Count = int64(br.uvarint())
+			for m := br.count(); m > 0 && br.err == nil; m-- {
+				han.IDS = append(han.IDS, str())
+			}
+		}
+
+		hans[codePoint] = han
+	}
+
+	headers := make(map[string]FileHeader)
+	if version >= 2 {
+		for n := br.count(); n > 0 && br.err == nil; n-- {
+			category := str()
+			headers[category] = FileHeader{
+				Name:           category,
+				Date:           str(),
+				UnicodeVersion: str(),
+			}
+
+			loaded[category] = true
+		}
+	}
+
+	// Older versions tell loaded categories by their data and headers only
+	if version >= 3 {
+		clear(loaded)
+		for n := br.count(); n > 0 && br.err == nil; n-- {
+			category := str()
+			if br.err == nil && !slices.Contains(categories, category) {
+				return ErrSnapshotFormat
+			}
+
+			loaded[category] = true
+		}
+	}
+
+	if br.err != nil {
+		return br.err
+	}
+
+	if br.r.Len() > 0 {
+		return ErrSnapshotFormat
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	// Older versions lack corpus counts and IDS data, kept as by Reload()
+	if version < 4 {
+		for codePoint, han := range hans {
+			if old := db.hans[codePoint]; old != nil {
+				han.Count = old.Count
+				han.IDS = old.IDS
+			}
+		}
+	}
+
+	clear(db.hans)
+	for codePoint, han := range hans {
+		db.hans[codePoint] = han
+	}
+
+	db.loaded = loaded
+	db.headers = headers
+	db.config = loadConfig{}
+
+	if db.compact {
+		err = db.packAll(hans)
+	}
+
+	db.index.Store(buildIndex(db.hans))
+	db.generation.Add(1)
+
+	return err
+}
+
+/* }}} */
+
+type snapshotReader struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (sr *snapshotReader) fail() {
+	if sr.err == nil {
+		sr.err = ErrSnapshotFormat
+	}
+}
+
+func (sr *snapshotReader) uvarint() uint64 {
+	if sr.err != nil {
+		return 0
+	}
+
+	v, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		sr.fail()
+	}
+
+	return v
+}
+
+// Counts are bounded so that corrupt input cannot trigger huge allocations
+func (sr *snapshotReader) count() int {
+	v := sr.uvarint()
+	if v > 1<<24 {
+		sr.fail()
+
+		return 0
+	}
+
+	return int(v)
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
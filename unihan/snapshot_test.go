@@ -0,0 +1,92 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file snapshot_test.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	db := loadTestdata(t, WithCategories(Readings, IRGSources))
+	err := db.LoadFrequency(strings.NewReader("我\t100\n黄\t50\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.LoadIDS(strings.NewReader("U+6797\t林\t⿰木木\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = db.WriteSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := New()
+	err = read.ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n, want := read.Count(), db.Count(); n != want {
+		t.Errorf("Count() = %d, want %d", n, want)
+	}
+
+	if loaded, want := read.Categories(), db.Categories(); !slices.Equal(loaded, want) {
+		t.Errorf("Categories() = %v, want %v", loaded, want)
+	}
+
+	if version := read.Version(); version != "16.0.0" {
+		t.Errorf("Version() = %q, want 16.0.0", version)
+	}
+
+	if han := read.GetHanByValue("我"); han.Count != 100 || han.Rank() != 1 {
+		t.Errorf("我 Count = %d, Rank() = %d, want 100 and 1", han.Count, han.Rank())
+	}
+
+	if components := read.GetHanByValue("林").Components(); !slices.Equal(components, []rune("木")) {
+		t.Errorf("林 Components() = %q, want 木", string(components))
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
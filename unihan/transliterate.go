@@ -0,0 +1,295 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file transliterate.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// PinyinStyle selects how syllables are written
+type PinyinStyle int
+
+const (
+	StyleToneMark   PinyinStyle = iota // zhòng
+	StyleToneNumber                    // zhong4
+	StyleToneless                      // zhong
+)
+
+// Segment is one Han character with its readings, or a run of other text passed through
+type Segment struct {
+	Text     string   `json:"text"`
+	Readings []string `json:"readings"` // Empty for text passed through
+}
+
+// PinyinDict resolves readings of polyphonic characters by words
+type PinyinDict interface {
+	// Match returns the longest known word at the beginning of text and its readings, one per character
+	Match(text string) (word string, readings []Pinyin, ok bool)
+}
+
+// WordDict is a longest-match PinyinDict held in memory
+type WordDict struct {
+	words  map[string][]Pinyin
+	maxLen int
+}
+
+// PinyinOptions controls transliteration, nil for defaults
+type PinyinOptions struct {
+	Style     PinyinStyle
+	Heteronym bool       // Output every reading, the chosen one first
+	Dict      PinyinDict // Word readings, may be nil
+	UseV      bool       // Write ü as v in tone number and toneless styles
+}
+
+// NewWordDict creates an empty word dictionary
+func NewWordDict() *WordDict {
+	return &WordDict{
+		words: make(map[string][]Pinyin),
+	}
+}
+
+// LoadWordDict reads a word dictionary, one "word<TAB>syllables" per line, "重庆	chóng qìng"
+func LoadWordDict(r io.Reader) (*WordDict, error) {
+	d := NewWordDict()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		word, syllables := cutField(text)
+		err := d.Add(word, strings.Fields(syllables)...)
+		if err != nil {
+			return nil, &ParseError{Line: line, Reason: err.Error()}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// ToPinyin transliterates text of the default database to pinyin
+func ToPinyin(text string, opts *PinyinOptions) []Segment {
+	return defaultDB.ToPinyin(text, opts)
+}
+
+// PinyinString transliterates text of the default database to a space separated pinyin string
+func PinyinString(text string, opts *PinyinOptions) string {
+	return defaultDB.PinyinString(text, opts)
+}
+
+/* {{{ [WordDict struct] */
+// Add registers a word and its syllables, tone marked or numbered
+func (d *WordDict) Add(word string, syllables ...string) error {
+	if utf8.RuneCountInString(word) != len(syllables) {
+		return fmt.Errorf("word %q has %d syllables", word, len(syllables))
+	}
+
+	readings := make([]Pinyin, len(syllables))
+	for i, s := range syllables {
+		p, err := ParsePinyin(s)
+		if err != nil {
+			return err
+		}
+
+		readings[i] = p
+	}
+
+	d.words[word] = readings
+	if n := len(readings); n > d.maxLen {
+		d.maxLen = n
+	}
+
+	return nil
+}
+
+// Match implements PinyinDict
+func (d *WordDict) Match(text string) (string, []Pinyin, bool) {
+	end := 0
+	var ends []int
+	for _, r := range text {
+		if len(ends) == d.maxLen {
+			break
+		}
+
+		end += utf8.RuneLen(r)
+		ends = append(ends, end)
+	}
+
+	for n := len(ends); n > 0; n-- {
+		word := text[:ends[n-1]]
+		if readings, ok := d.words[word]; ok {
+			return word, readings, true
+		}
+	}
+
+	return "", nil, false
+}
+
+/* }}} */
+
+/* {{{ [PinyinOptions struct] */
+func (o *PinyinOptions) format(p Pinyin) string {
+	var s string
+	switch o.Style {
+	case StyleToneNumber:
+		s = p.ToneNumber()
+	case StyleToneless:
+		s = p.Toneless()
+	default:
+		return p.ToneMark()
+	}
+
+	if o.UseV {
+		s = strings.ReplaceAll(s, "ü", "v")
+	}
+
+	return s
+}
+
+/* }}} */
+
+/* {{{ [DB struct] */
+// ToPinyin transliterates text to pinyin, characters without Mandarin reading are passed through
+func (db *DB) ToPinyin(text string, opts *PinyinOptions) []Segment {
+	if opts == nil {
+		opts = &PinyinOptions{}
+	}
+
+	var segments []Segment
+	for i := 0; i < len(text); {
+		var word string
+		var chosen []Pinyin
+		if opts.Dict != nil {
+			word, chosen, _ = opts.Dict.Match(text[i:])
+		}
+
+		if word == "" {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			word = text[i : i+size]
+		}
+
+		n := 0
+		for _, r := range word {
+			var readings []Pinyin
+			if han := db.GetHanByCodePoint(r); han != nil {
+				readings = han.PinyinReadings()
+			}
+
+			if n < len(chosen) {
+				readings = append([]Pinyin{chosen[n]}, readings...)
+			}
+
+			n++
+			formatted := make([]string, len(readings))
+			for i, p := range readings {
+				formatted[i] = opts.format(p)
+			}
+
+			segments = appendSegment(segments, string(r), formatted, opts.Heteronym)
+		}
+
+		i += len(word)
+	}
+
+	return segments
+}
+
+// PinyinString transliterates text to pinyin, syllables and passed through text are separated by spaces
+func (db *DB) PinyinString(text string, opts *PinyinOptions) string {
+	return joinSegments(db.ToPinyin(text, opts))
+}
+
+/* }}} */
+
+// Append a character, runs of characters without readings are merged
+func appendSegment(segments []Segment, text string, readings []string, heteronym bool) []Segment {
+	if len(readings) == 0 {
+		if n := len(segments); n > 0 && len(segments[n-1].Readings) == 0 {
+			segments[n-1].Text += text
+		} else {
+			segments = append(segments, Segment{Text: text})
+		}
+
+		return segments
+	}
+
+	if !heteronym {
+		readings = readings[:1]
+	}
+
+	segment := Segment{
+		Text: text,
+	}
+
+	for _, reading := range readings {
+		if !slices.Contains(segment.Readings, reading) {
+			segment.Readings = append(segment.Readings, reading)
+		}
+	}
+
+	return append(segments, segment)
+}
+
+// First reading of every character, and passed through text
+func joinSegments(segments []Segment) string {
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		part := strings.TrimSpace(segment.Text)
+		if len(segment.Readings) > 0 {
+			part = segment.Readings[0]
+		}
+
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -30,41 +30,77 @@
 
 package unihan
 
-import "unicode/utf8"
+import (
+	"iter"
+	"slices"
+	"unicode/utf8"
+)
 
 func GetHanByUnicode(unicode string) *Han {
-	DatabaseLock.Lock()
-	defer DatabaseLock.Unlock()
+	return defaultDB.GetHanByUnicode(unicode)
+}
 
-	codePoint := UnicodeToRune(unicode)
-	han, _ := Database[codePoint]
+func GetHanByCodePoint(codePoint rune) *Han {
+	return defaultDB.GetHanByCodePoint(codePoint)
+}
+
+func GetHanByValue(value string) *Han {
+	return defaultDB.GetHanByValue(value)
+}
 
-	return han
+// All iterates characters of the default database, see DB.All()
+func All() iter.Seq[*Han] {
+	return defaultDB.All()
 }
 
-func GetHanByCodePoint(codePoint rune) *Han {
-	DatabaseLock.Lock()
-	defer DatabaseLock.Unlock()
+/* {{{ [DB struct] */
+func (db *DB) GetHanByUnicode(unicode string) *Han {
+	codePoint := UnicodeToRune(unicode)
 
+	return db.lookup(codePoint)
+}
+
+func (db *DB) GetHanByCodePoint(codePoint rune) *Han {
 	if codePoint > 0 {
-		return Database[codePoint]
+		return db.lookup(codePoint)
 	}
 
 	return nil
 }
 
-func GetHanByValue(value string) *Han {
+func (db *DB) GetHanByValue(value string) *Han {
 	codePoint, _ := utf8.DecodeRuneInString(value)
-	DatabaseLock.Lock()
-	defer DatabaseLock.Unlock()
-
 	if codePoint > 0 {
-		return Database[codePoint]
+		return db.lookup(codePoint)
 	}
 
 	return nil
 }
 
+// All iterates characters ordered by code point, over the characters present
+// when iteration starts
+func (db *DB) All() iter.Seq[*Han] {
+	return func(yield func(*Han) bool) {
+		view := db.view()
+		hans := make([]*Han, 0, len(view))
+		for _, han := range view {
+			hans = append(hans, han)
+		}
+
+		slices.SortFunc(hans, func(a, b *Han) int {
+			return int(a.CodePoint - b.CodePoint)
+		})
+
+		for _, han := range hans {
+			if !yield(han) {
+				return
+			}
+		}
+	}
+}
+
+/* }}} */
+
 /*
  * Local variables:
  * tab-width: 4
@@ -0,0 +1,84 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file unihan_test.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"sync"
+	"testing"
+)
+
+// Parallel lookups by code point, lock free through the published indexes,
+// next to the same lookups serialized by an exclusive lock as the read path
+// used to be. Run with -cpu 1,2,4,8 to compare scaling
+func BenchmarkGetHanByCodePoint(b *testing.B) {
+	db := loadTestdata(b)
+	var codePoints []rune
+	for han := range db.All() {
+		codePoints = append(codePoints, han.CodePoint)
+	}
+
+	var exclusive sync.Mutex
+	lookups := []struct {
+		name   string
+		lookup func(rune) *Han
+	}{
+		{"lock-free", db.GetHanByCodePoint},
+		{"exclusive", func(codePoint rune) *Han {
+			exclusive.Lock()
+			defer exclusive.Unlock()
+
+			return db.GetHanByCodePoint(codePoint)
+		}},
+	}
+
+	for _, lookup := range lookups {
+		b.Run(lookup.name, func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if lookup.lookup(codePoints[i%len(codePoints)]) == nil {
+						b.Error("lookup failed")
+
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
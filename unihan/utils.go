@@ -31,21 +31,52 @@
 package unihan
 
 import (
-	"regexp"
+	"fmt"
 	"strconv"
+	"strings"
+	"unicode"
 )
 
+// UnicodeToRune converts "U+XXXX" to its code point, 0 if malformed, see ParseUnicode()
 func UnicodeToRune(code string) rune {
-	re := regexp.MustCompile(`U+(.+)`)
-	matches := re.FindStringSubmatch(code)
-	if len(matches) == 2 {
-		i, _ := strconv.ParseInt(matches[1], 16, 64)
-		if i > 0 {
-			return rune(i)
+	codePoint, _ := ParseUnicode(code)
+
+	return codePoint
+}
+
+// ParseUnicode converts "U+XXXX" (4 to 6 hex digits) to its code point
+func ParseUnicode(s string) (rune, error) {
+	hex, ok := strings.CutPrefix(s, "U+")
+	if !ok || len(hex) < 4 || len(hex) > 6 {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+
+	i, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || i == 0 || i > unicode.MaxRune {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+
+	return rune(i), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
 		}
 	}
 
-	return 0
+	return s != ""
+}
+
+// Decimal value of a string already checked by isDigits
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+
+	return n
 }
 
 /*
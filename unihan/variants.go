@@ -0,0 +1,292 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file variants.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// VariantKind is the unihan field a variant relation comes from
+type VariantKind string
+
+const (
+	VariantSemantic            VariantKind = "kSemanticVariant"
+	VariantSpecializedSemantic VariantKind = "kSpecializedSemanticVariant"
+	VariantZ                   VariantKind = "kZVariant"
+	VariantSpoofing            VariantKind = "kSpoofingVariant"
+	VariantSimplified          VariantKind = FieldSimplifiedVariant
+	VariantTraditional         VariantKind = FieldTraditionalVariant
+)
+
+// All kinds followed by the graph
+var VariantKinds = []VariantKind{
+	VariantSemantic,
+	VariantSpecializedSemantic,
+	VariantZ,
+	VariantSpoofing,
+	VariantSimplified,
+	VariantTraditional,
+}
+
+// VariantSource is one source annotation of a variant, "kMatthews:T"
+type VariantSource struct {
+	Dictionary string `json:"dictionary"`
+	Flags      string `json:"flags"` // T, B, Z, F, J
+}
+
+// VariantRef is one variant value, "U+5B78<kMatthews:T,kMeyerWempe"
+type VariantRef struct {
+	CodePoint rune            `json:"code_point"`
+	Sources   []VariantSource `json:"sources"`
+}
+
+// VariantEdge links a character to one of its variants
+type VariantEdge struct {
+	From    rune            `json:"from"`
+	To      rune            `json:"to"`
+	Kind    VariantKind     `json:"kind"`
+	Sources []VariantSource `json:"sources"`
+	Inverse bool            `json:"inverse"` // Derived from the variant data of To
+}
+
+// VariantGraph holds variant relations of a database, edges go both ways
+type VariantGraph struct {
+	edges map[rune][]VariantEdge
+}
+
+// ParseVariantRef parses a variant value with optional source annotations
+func ParseVariantRef(s string) (VariantRef, error) {
+	code, annotations, _ := strings.Cut(s, "<")
+	codePoint, err := ParseUnicode(code)
+	if err != nil {
+		return VariantRef{}, fmt.Errorf("invalid variant %q", s)
+	}
+
+	ref := VariantRef{
+		CodePoint: codePoint,
+	}
+
+	if annotations == "" {
+		return ref, nil
+	}
+
+	for _, annotation := range strings.Split(annotations, ",") {
+		dictionary, flags, _ := strings.Cut(annotation, ":")
+		if !strings.HasPrefix(dictionary, "k") {
+			return VariantRef{}, fmt.Errorf("invalid variant source %q", s)
+		}
+
+		ref.Sources = append(ref.Sources, VariantSource{
+			Dictionary: dictionary,
+			Flags:      flags,
+		})
+	}
+
+	return ref, nil
+}
+
+// NewVariantGraph builds the variant graph of db, the default database if nil
+func NewVariantGraph(db *DB) *VariantGraph {
+	if db == nil {
+		db = defaultDB
+	}
+
+	g := &VariantGraph{
+		edges: make(map[rune][]VariantEdge),
+	}
+
+	for codePoint, han := range db.view() {
+		for _, kind := range VariantKinds {
+			for _, value := range han.fieldValues(string(kind)) {
+				ref, err := ParseVariantRef(value)
+				if err != nil || ref.CodePoint == codePoint {
+					continue
+				}
+
+				g.add(VariantEdge{
+					From:    codePoint,
+					To:      ref.CodePoint,
+					Kind:    kind,
+					Sources: ref.Sources,
+				})
+				g.add(VariantEdge{
+					From:    ref.CodePoint,
+					To:      codePoint,
+					Kind:    kind.inverse(),
+					Sources: ref.Sources,
+					Inverse: true,
+				})
+			}
+		}
+	}
+
+	for _, edges := range g.edges {
+		slices.SortFunc(edges, func(a, b VariantEdge) int {
+			if a.To != b.To {
+				return int(a.To - b.To)
+			}
+
+			return strings.Compare(string(a.Kind), string(b.Kind))
+		})
+	}
+
+	return g
+}
+
+/* {{{ [VariantKind] */
+// Relation seen from the other end
+func (k VariantKind) inverse() VariantKind {
+	switch k {
+	case VariantSimplified:
+		return VariantTraditional
+	case VariantTraditional:
+		return VariantSimplified
+	}
+
+	return k
+}
+
+/* }}} */
+
+/* {{{ [DB struct] */
+// VariantGraph builds the variant graph of the database
+func (db *DB) VariantGraph() *VariantGraph {
+	return NewVariantGraph(db)
+}
+
+/* }}} */
+
+/* {{{ [VariantGraph struct] */
+// Variants returns direct variant edges of r, all kinds if none given
+func (g *VariantGraph) Variants(r rune, kinds ...VariantKind) []VariantEdge {
+	var edges []VariantEdge
+	for _, edge := range g.edges[r] {
+		if matchKind(edge.Kind, kinds) {
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges
+}
+
+// Closure returns r and every character reachable through variants of the
+// given kinds (all kinds if none), ordered by code point
+func (g *VariantGraph) Closure(r rune, kinds ...VariantKind) []rune {
+	seen := map[rune]bool{r: true}
+	queue := []rune{r}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range g.edges[current] {
+			if !seen[edge.To] && matchKind(edge.Kind, kinds) {
+				seen[edge.To] = true
+				queue = append(queue, edge.To)
+			}
+		}
+	}
+
+	closure := make([]rune, 0, len(seen))
+	for codePoint := range seen {
+		closure = append(closure, codePoint)
+	}
+
+	slices.Sort(closure)
+
+	return closure
+}
+
+// ShortestPath returns the fewest edges leading from one character to another
+// through variants of the given kinds, nil if unreachable
+func (g *VariantGraph) ShortestPath(from, to rune, kinds ...VariantKind) []VariantEdge {
+	if from == to {
+		return []VariantEdge{}
+	}
+
+	prev := map[rune]VariantEdge{}
+	queue := []rune{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range g.edges[current] {
+			if _, ok := prev[edge.To]; ok || edge.To == from || !matchKind(edge.Kind, kinds) {
+				continue
+			}
+
+			prev[edge.To] = edge
+			if edge.To == to {
+				var path []VariantEdge
+				for r := to; r != from; r = prev[r].From {
+					path = append(path, prev[r])
+				}
+
+				slices.Reverse(path)
+
+				return path
+			}
+
+			queue = append(queue, edge.To)
+		}
+	}
+
+	return nil
+}
+
+func (g *VariantGraph) add(edge VariantEdge) {
+	for i, e := range g.edges[edge.From] {
+		if e.To == edge.To && e.Kind == edge.Kind {
+			// Declared on both sides, keep the direct one
+			if e.Inverse && !edge.Inverse {
+				g.edges[edge.From][i] = edge
+			}
+
+			return
+		}
+	}
+
+	g.edges[edge.From] = append(g.edges[edge.From], edge)
+}
+
+/* }}} */
+
+func matchKind(kind VariantKind, kinds []VariantKind) bool {
+	return len(kinds) == 0 || slices.Contains(kinds, kind)
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
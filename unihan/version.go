@@ -0,0 +1,369 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2025 BS.Group
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file version.go
+ * @package unihan
+ * @author Dr.NP <np@herewe.tech>
+ * @since 10/17/2026
+ */
+
+package unihan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FieldSchema describes a field of UAX #38 across Unicode versions
+type FieldSchema struct {
+	Name     string   `json:"name"`
+	Files    []string `json:"files"`   // Source files holding the field, the current one first
+	Since    string   `json:"since"`   // Unicode version introducing the field, empty if older than tracked
+	Removed  string   `json:"removed"` // Unicode version removing the field, empty if current
+	Replaced string   `json:"replaced,omitempty"`
+}
+
+// IssueKind classifies validation issues
+type IssueKind string
+
+const (
+	IssueUnknownField    IssueKind = "unknown-field"    // Not a field of UAX #38
+	IssueRemovedField    IssueKind = "removed-field"    // Removed by the Unicode version of the data, or earlier
+	IssueUnreleasedField IssueKind = "unreleased-field" // Introduced after the Unicode version of the data
+	IssueMisplacedField  IssueKind = "misplaced-field"  // Found in an unexpected source file
+	IssueVersionMismatch IssueKind = "version-mismatch" // Source files of different Unicode versions
+	IssueMissingHeader   IssueKind = "missing-header"   // No Unicode version in the file header
+)
+
+// ValidationIssue is one finding of Validate()
+type ValidationIssue struct {
+	Kind    IssueKind `json:"kind"`
+	File    string    `json:"file"`
+	Field   string    `json:"field,omitempty"`
+	Message string    `json:"message"`
+}
+
+// Schema lists the fields of UAX #38 tracked for validation
+var Schema = []FieldSchema{
+	// Dictionary indices
+	{Name: "kCheungBauerIndex", Files: []string{DictionaryIndices}},
+	{Name: "kCowles", Files: []string{DictionaryIndices}},
+	{Name: "kDaeJaweon", Files: []string{DictionaryIndices}},
+	{Name: "kFennIndex", Files: []string{DictionaryIndices}},
+	{Name: "kGSR", Files: []string{DictionaryIndices}},
+	{Name: FieldHanYu, Files: []string{DictionaryIndices}},
+	{Name: "kIRGDaeJaweon", Files: []string{DictionaryIndices}},
+	{Name: "kIRGDaiKanwaZiten", Files: []string{DictionaryIndices}, Removed: "15.1", Replaced: "kMorohashi"},
+	{Name: "kIRGHanyuDaZidian", Files: []string{DictionaryIndices}},
+	{Name: "kIRGKangXi", Files: []string{DictionaryIndices}},
+	{Name: FieldKangXi, Files: []string{DictionaryIndices}},
+	{Name: "kKarlgren", Files: []string{DictionaryIndices}},
+	{Name: "kLau", Files: []string{DictionaryIndices}},
+	{Name: "kMatthews", Files: []string{DictionaryIndices}},
+	{Name: "kMorohashi", Files: []string{DictionaryIndices}},
+	{Name: "kNelson", Files: []string{DictionaryIndices}},
+	{Name: "kSBGY", Files: []string{DictionaryIndices}},
+	{Name: "kSMSZD2003Index", Files: []string{DictionaryIndices}, Since: "15.1"},
+
+	// Dictionary-like data
+	{Name: "kAlternateTotalStrokes", Files: []string{DictionaryLikeData}, Since: "15.0"},
+	{Name: FieldCangjie, Files: []string{DictionaryLikeData}},
+	{Name: "kCheungBauer", Files: []string{DictionaryLikeData}},
+	{Name: "kCihaiT", Files: []string{DictionaryLikeData}},
+	{Name: "kFenn", Files: []string{DictionaryLikeData}},
+	{Name: FieldFourCornerCode, Files: []string{DictionaryLikeData}},
+	{Name: FieldFrequency, Files: []string{DictionaryLikeData}},
+	{Name: FieldGradeLevel, Files: []string{DictionaryLikeData}},
+	{Name: "kHDZRadBreak", Files: []string{DictionaryLikeData}},
+	{Name: "kHKGlyph", Files: []string{DictionaryLikeData}},
+	{Name: "kMojiJoho", Files: []string{DictionaryLikeData}, Since: "15.1"},
+	{Name: "kPhonetic", Files: []string{DictionaryLikeData}},
+	{Name: "kStrange", Files: []string{DictionaryLikeData}, Since: "14.0"},
+	{Name: "kUnihanCore2020", Files: []string{DictionaryLikeData}, Since: "13.0"},
+
+	// IRG sources
+	{Name: "kCompatibilityVariant", Files: []string{IRGSources}},
+	{Name: "kIICore", Files: []string{IRGSources}},
+	{Name: FieldIRGGSource, Files: []string{IRGSources}},
+	{Name: "kIRG_HSource", Files: []string{IRGSources}},
+	{Name: FieldIRGJSource, Files: []string{IRGSources}},
+	{Name: "kIRG_KPSource", Files: []string{IRGSources}},
+	{Name: "kIRG_KSource", Files: []string{IRGSources}},
+	{Name: "kIRG_MSource", Files: []string{IRGSources}},
+	{Name: "kIRG_SSource", Files: []string{IRGSources}, Since: "13.0"},
+	{Name: FieldIRGTSource, Files: []string{IRGSources}},
+	{Name: "kIRG_UKSource", Files: []string{IRGSources}, Since: "13.0"},
+	{Name: "kIRG_USource", Files: []string{IRGSources}},
+	{Name: "kIRG_VSource", Files: []string{IRGSources}},
+	{Name: FieldRSUnicode, Files: []string{IRGSources, RadicalStrokeCounts}},
+	{Name: FieldTotalStrokes, Files: []string{IRGSources, DictionaryLikeData}},
+
+	// Numeric values
+	{Name: "kAccountingNumeric", Files: []string{NumericValues}},
+	{Name: "kOtherNumeric", Files: []string{NumericValues}},
+	{Name: "kPrimaryNumeric", Files: []string{NumericValues}},
+	{Name: "kVietnameseNumeric", Files: []string{NumericValues}, Since: "15.1"},
+	{Name: "kZhuangNumeric", Files: []string{NumericValues}, Since: "15.1"},
+
+	// Other mappings
+	{Name: FieldBigFive, Files: []string{OtherMappings}},
+	{Name: "kCCCII", Files: []string{OtherMappings}},
+	{Name: "kCNS1986", Files: []string{OtherMappings}},
+	{Name: "kCNS1992", Files: []string{OtherMappings}},
+	{Name: "kEACC", Files: []string{OtherMappings}},
+	{Name: FieldGB0, Files: []string{OtherMappings}},
+	{Name: FieldGB1, Files: []string{OtherMappings}},
+	{Name: "kGB3", Files: []string{OtherMappings}},
+	{Name: "kGB5", Files: []string{OtherMappings}},
+	{Name: "kGB7", Files: []string{OtherMappings}},
+	{Name: "kGB8", Files: []string{OtherMappings}},
+	{Name: "kHKSCS", Files: []string{OtherMappings}},
+	{Name: "kIBMJapan", Files: []string{OtherMappings}},
+	{Name: "kJa", Files: []string{OtherMappings}},
+	{Name: "kJinmeiyoKanji", Files: []string{OtherMappings}},
+	{Name: FieldJis0, Files: []string{OtherMappings}},
+	{Name: "kJis1", Files: []string{OtherMappings}},
+	{Name: "kJIS0213", Files: []string{OtherMappings}},
+	{Name: "kJoyoKanji", Files: []string{OtherMappings}},
+	{Name: "kKoreanEducationHanja", Files: []string{OtherMappings}},
+	{Name: "kKoreanName", Files: []string{OtherMappings}},
+	{Name: "kKPS0", Files: []string{OtherMappings}},
+	{Name: "kKPS1", Files: []string{OtherMappings}},
+	{Name: "kKSC0", Files: []string{OtherMappings}},
+	{Name: "kKSC1", Files: []string{OtherMappings}},
+	{Name: "kMainlandTelegraph", Files: []string{OtherMappings}},
+	{Name: "kPseudoGB1", Files: []string{OtherMappings}},
+	{Name: "kTaiwanTelegraph", Files: []string{OtherMappings}},
+	{Name: FieldTGH, Files: []string{OtherMappings}},
+	{Name: "kXerox", Files: []string{OtherMappings}},
+
+	// Radical-stroke counts
+	{Name: "kRSAdobe_Japan1_6", Files: []string{RadicalStrokeCounts}},
+	{Name: "kRSJapanese", Files: []string{RadicalStrokeCounts}, Removed: "13.0"},
+	{Name: "kRSKangXi", Files: []string{RadicalStrokeCounts}},
+	{Name: "kRSKanWa", Files: []string{RadicalStrokeCounts}, Removed: "13.0"},
+	{Name: "kRSKorean", Files: []string{RadicalStrokeCounts}, Removed: "13.0"},
+
+	// Readings
+	{Name: FieldCantonese, Files: []string{Readings}},
+	{Name: FieldDefinition, Files: []string{Readings}},
+	{Name: "kFanqie", Files: []string{Readings}, Since: "16.0"},
+	{Name: FieldHangul, Files: []string{Readings}},
+	{Name: FieldHanyuPinlu, Files: []string{Readings}},
+	{Name: FieldHanyuPinyin, Files: []string{Readings}},
+	{Name: FieldJapanese, Files: []string{Readings}, Since: "15.1"},
+	{Name: FieldJapaneseKun, Files: []string{Readings}},
+	{Name: FieldJapaneseOn, Files: []string{Readings}},
+	{Name: FieldKorean, Files: []string{Readings}},
+	{Name: FieldMandarin, Files: []string{Readings}},
+	{Name: "kSMSZD2003Readings", Files: []string{Readings}, Since: "15.1"},
+	{Name: "kTang", Files: []string{Readings}},
+	{Name: FieldTGHZ2013, Files: []string{Readings}},
+	{Name: FieldVietnamese, Files: []string{Readings}},
+	{Name: FieldXHC1983, Files: []string{Readings}},
+	{Name: "kZhuang", Files: []string{Readings}, Since: "16.0"},
+
+	// Variants
+	{Name: string(VariantSemantic), Files: []string{Variants}},
+	{Name: string(VariantSimplified), Files: []string{Variants}},
+	{Name: string(VariantSpecializedSemantic), Files: []string{Variants}},
+	{Name: string(VariantSpoofing), Files: []string{Variants}, Since: "13.0"},
+	{Name: string(VariantTraditional), Files: []string{Variants}},
+	{Name: string(VariantZ), Files: []string{Variants}},
+}
+
+// Version returns the Unicode version of the default database
+func Version() string {
+	return defaultDB.Version()
+}
+
+// Validate checks the default database against Schema
+func Validate() []ValidationIssue {
+	return defaultDB.Validate()
+}
+
+/* {{{ [DB struct] */
+// Version returns the Unicode version given by the source file headers, the
+// highest one if files disagree (see Validate()), empty if unknown
+func (db *DB) Version() string {
+	version := ""
+	for _, header := range db.Headers() {
+		if compareVersions(header.UnicodeVersion, version) > 0 {
+			version = header.UnicodeVersion
+		}
+	}
+
+	return version
+}
+
+// Headers returns headers of the loaded source files, in loading order
+func (db *DB) Headers() []FileHeader {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	var headers []FileHeader
+	for _, category := range categories {
+		if header, ok := db.headers[category]; ok {
+			if header.Name == "" {
+				header.Name = category
+			}
+
+			headers = append(headers, header)
+		}
+	}
+
+	return headers
+}
+
+// Validate checks file headers and every loaded field against Schema for the
+// Unicode version of the data. Fields are checked by name and source file,
+// issues are ordered by file and field
+func (db *DB) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+	version := db.Version()
+	for _, header := range db.Headers() {
+		switch {
+		case header.UnicodeVersion == "":
+			issues = append(issues, ValidationIssue{
+				Kind:    IssueMissingHeader,
+				File:    header.Name,
+				Message: "no unicode version in file header",
+			})
+		case header.UnicodeVersion != version:
+			issues = append(issues, ValidationIssue{
+				Kind:    IssueVersionMismatch,
+				File:    header.Name,
+				Message: fmt.Sprintf("unicode version %s, other files are %s", header.UnicodeVersion, version),
+			})
+		}
+	}
+
+	// Distinct fields of every file
+	seen := make(map[[2]string]bool)
+	for _, han := range db.view() {
+		for _, category := range categories {
+			for name := range han.categoryFields(category) {
+				seen[[2]string{category, name}] = true
+			}
+		}
+	}
+
+	for key := range seen {
+		category, name := key[0], key[1]
+		issue := ValidationIssue{File: category, Field: name}
+		schema := schemaField(name)
+		switch {
+		case schema == nil:
+			issue.Kind = IssueUnknownField
+			issue.Message = "field not defined by UAX #38"
+		case schema.Removed != "" && (version == "" || compareVersions(version, schema.Removed) >= 0):
+			issue.Kind = IssueRemovedField
+			issue.Message = "field removed in unicode " + schema.Removed
+			if schema.Replaced != "" {
+				issue.Message += ", replaced by " + schema.Replaced
+			}
+		case schema.Since != "" && version != "" && compareVersions(version, schema.Since) < 0:
+			issue.Kind = IssueUnreleasedField
+			issue.Message = fmt.Sprintf("field introduced in unicode %s, data is %s", schema.Since, version)
+		case !slices.Contains(schema.Files, category):
+			issue.Kind = IssueMisplacedField
+			issue.Message = "field expected in " + schema.Files[0]
+		default:
+			continue
+		}
+
+		issues = append(issues, issue)
+	}
+
+	slices.SortStableFunc(issues, func(a, b ValidationIssue) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(categories, a.File), slices.Index(categories, b.File)),
+			strings.Compare(a.Field, b.Field),
+		)
+	})
+
+	return issues
+}
+
+/* }}} */
+
+/* {{{ [ValidationIssue struct] */
+func (i ValidationIssue) String() string {
+	if i.Field == "" {
+		return fmt.Sprintf("%s: %s: %s", i.File, i.Kind, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s %s: %s", i.File, i.Kind, i.Field, i.Message)
+}
+
+/* }}} */
+
+// Schema entry of a field, nil if unknown
+func schemaField(name string) *FieldSchema {
+	for i := range Schema {
+		if Schema[i].Name == name {
+			return &Schema[i]
+		}
+	}
+
+	return nil
+}
+
+// Compare dotted versions numerically, "15.1" < "15.1.0" < "16.0.0", empty is lowest
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	if a == "" || b == "" {
+		return cmp.Compare(len(a), len(b))
+	}
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		if i >= len(as) {
+			return -1
+		}
+
+		if i >= len(bs) {
+			return 1
+		}
+
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if c := cmp.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */